@@ -3,8 +3,6 @@ package main
 import (
 	"fmt"
 	"log"
-	"path/filepath"
-	"runtime"
 
 	"github.com/hotrungnhan/go-automapper"
 )
@@ -82,13 +80,8 @@ func main() {
 	fmt.Printf("Original: %+v\n", employee)
 	fmt.Printf("Mapped:   %+v (Salary field ignored)\n", employeeDTO)
 
-	// Example 3: Using local import for autoMap
-	fmt.Println("\n3. Direct AutoMap (using local package):")
-
-	// Get current directory for local import
-	_, currentFile, _, _ := runtime.Caller(0)
-	projectRoot := filepath.Dir(filepath.Dir(currentFile))
-	fmt.Printf("Note: For direct autoMap, import from: %s\n", projectRoot)
+	// Example 3: Direct field copy without registration
+	fmt.Println("\n3. Direct Field Copy (CopyFields):")
 
 	anotherPerson := Person{
 		Name:  "Charlie Brown",
@@ -96,8 +89,13 @@ func main() {
 		Email: "charlie@example.com",
 	}
 
+	copiedDTO, err := mapper.CopyFields[Person, PersonDTO](anotherPerson)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	fmt.Printf("Original: %+v\n", anotherPerson)
-	fmt.Println("(Direct autoMap function is not exported, use RegisterAutoMap instead)")
+	fmt.Printf("Copied:   %+v\n", copiedDTO)
 
 	// Example 4: Bidirectional mapping
 	fmt.Println("\n4. Bidirectional Mapping:")
@@ -5,7 +5,11 @@ package mapper
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
 )
 
 // Global is a default mapper instance that can be used for convenience.
@@ -14,15 +18,70 @@ var Global = New()
 
 // typePair represents a mapping relationship between source and destination types.
 // It serves as the key in the mapper registry to identify registered mapping functions.
+// reflect.Type values are always comparable, so pairs of any types, including func and
+// map types, are valid keys; only a nil reflect.Type must be kept out of them.
 type typePair struct {
 	src reflect.Type
 	dst reflect.Type
 }
 
+// pairOf builds the registry key used to look up a mapping from src to dst.
+// Pointer types are reduced to their element type so that T and *T share a key.
+func pairOf(src, dst reflect.Type) typePair {
+	if src.Kind() == reflect.Ptr {
+		src = src.Elem()
+	}
+	if dst.Kind() == reflect.Ptr {
+		dst = dst.Elem()
+	}
+	return typePair{src: src, dst: dst}
+}
+
+// keyOf returns the registry key of the S -> D mapping, with pointers stripped by pairOf.
+func keyOf[S any, D any]() typePair {
+	return pairOf(typeOf[S](), typeOf[D]())
+}
+
+// typeOf returns the reflect.Type of T, including interface types that reflect.TypeOf
+// would report as nil when given a nil interface value.
+//
+// The result is deliberately not memoized: reflect.TypeFor lets the compiler resolve the
+// type descriptor of a concrete T statically, so this costs well under a nanosecond,
+// whereas a sync.Map keyed per instantiation measured over 30 times slower. Interface
+// types take a slower path of a few nanoseconds. The dominant cost of a registry lookup
+// is hashing the typePair key, not computing the types.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
 // Mapper is the main mapping registry that stores mapping functions between type pairs.
 // Each mapper instance maintains its own independent registry of mapping functions.
+//
+// The registry is copy-on-write: mapping functions are looked up without locking, and
+// Register, RegisterMany, the RegisterAutoMap family, Remove and WithOverride swap in an
+// updated copy. Mappings may therefore be registered and removed while other goroutines
+// map with the same mapper. RegisterWhen, SetFallback and the other settings are not
+// synchronized and should be configured before the mapper is shared.
 type Mapper struct {
-	registry map[typePair]interface{}
+	registry *registry
+	config   *config
+}
+
+// config holds mapper-wide settings. It is stored behind a pointer so that every copy
+// of a Mapper value observes the same settings, just like the shared registry.
+type config struct {
+	fallback   func(src any, dstType reflect.Type) (any, error)
+	resolver   func(src, dst reflect.Type) (any, bool)
+	stats      *stats
+	conditions map[typePair][]conditionalMapping
+	autoMap    AutoMapOptions
+	budget     *autoMapBudget
+
+	nilDefaults map[typePair]reflect.Value
+	postProcess map[reflect.Type][]func(reflect.Value)
+	errorMaps   map[reflect.Type]reflect.Type
+
+	globalIgnore []string
 }
 
 // ErrNoMapping is returned when attempting to map between types that don't have
@@ -34,9 +93,88 @@ var ErrNoMapping = errors.New("no mapping function registered for this type pair
 // when performing operations that require slice types.
 var ErrSrcAndDestMustBeSlices = errors.New("both source and destination must be slices")
 
+// SliceTypeError is returned by MapSlice and the other slice helpers when a type parameter
+// that must be a slice is not. It names the function and the actual kinds of both sides,
+// such as "MapSlice: source is slice but destination is struct", which makes a swapped or
+// mistyped type argument quick to spot. It unwraps to ErrSrcAndDestMustBeSlices, so
+// errors.Is(err, ErrSrcAndDestMustBeSlices) keeps working.
+type SliceTypeError struct {
+	// Func is the name of the function that rejected the types.
+	Func string
+
+	// Src is the source type, or nil if the source was a nil interface.
+	Src reflect.Type
+
+	// Dst is the destination type, or nil if the function takes no destination slice.
+	Dst reflect.Type
+}
+
+// Error describes which side is not a slice.
+func (e *SliceTypeError) Error() string {
+	if e.Dst == nil {
+		return fmt.Sprintf("%s: source is %s, want slice", e.Func, kindName(e.Src))
+	}
+	if !isSlice(e.Src) && !isSlice(e.Dst) {
+		return fmt.Sprintf("%s: source is %s and destination is %s", e.Func, kindName(e.Src), kindName(e.Dst))
+	}
+	return fmt.Sprintf("%s: source is %s but destination is %s", e.Func, kindName(e.Src), kindName(e.Dst))
+}
+
+// Unwrap returns ErrSrcAndDestMustBeSlices.
+func (e *SliceTypeError) Unwrap() error {
+	return ErrSrcAndDestMustBeSlices
+}
+
+// isSlice reports whether t is a slice type.
+func isSlice(t reflect.Type) bool {
+	return t != nil && t.Kind() == reflect.Slice
+}
+
+// kindName returns the kind of t for error messages, or "nil" for a nil type.
+func kindName(t reflect.Type) string {
+	if t == nil {
+		return "nil"
+	}
+	return t.Kind().String()
+}
+
+// ErrEmptySlice is returned when a function needs at least one element from the source
+// slice (such as MapFirst) but the slice is nil or empty.
+var ErrEmptySlice = errors.New("source slice is empty")
+
+// ErrInvalidMappingFunc is returned when a value passed for registration is not a
+// function with exactly one parameter and one result.
+var ErrInvalidMappingFunc = errors.New("invalid mapping function")
+
+// ErrNilMappingFunc is reported when a nil function is passed for registration.
+var ErrNilMappingFunc = errors.New("cannot register nil mapping function")
+
+// ErrSignatureMismatch is returned when a registered function cannot be called for the
+// type pair it is stored under, for example because it was inserted with the wrong
+// signature. The reflection panic that detected the mismatch is included in the message.
+var ErrSignatureMismatch = errors.New("mapping function signature mismatch")
+
+// ErrFallbackResultType is returned when the fallback function installed with SetFallback
+// produces a value that cannot be used as the requested destination type.
+var ErrFallbackResultType = errors.New("fallback returned a value of the wrong type")
+
+// ErrAutoMapUnsupported is returned when auto-mapping is requested for types that are not
+// structs or pointers to structs and are not identical, which copier cannot meaningfully map.
+var ErrAutoMapUnsupported = errors.New("auto-mapping requires struct types")
+
+// ErrUntypedNil is returned by Map when the source is a nil interface value, such as a nil
+// passed through Map[any, D]. Such a value carries no dynamic type to look a mapping up by.
+var ErrUntypedNil = errors.New("cannot map from untyped nil source")
+
+// Option configures optional mapper behavior at construction time.
+type Option func(*config)
+
 // New creates a new Mapper instance with an empty registry.
 // Each mapper maintains its own independent registry of mapping functions.
 //
+// Parameters:
+//   - opts: Optional settings such as WithStats
+//
 // Returns:
 //   - Mapper: A new mapper instance with an initialized empty registry
 //
@@ -45,16 +183,45 @@ var ErrSrcAndDestMustBeSlices = errors.New("both source and destination must be
 //	mapper := New()
 //	Register(mapper, func(s string) int { return len(s) })
 //	result, err := Map[string, int](mapper, "hello")
-func New() Mapper {
-	return Mapper{
-		registry: make(map[typePair]interface{}),
+func New(opts ...Option) Mapper {
+	m := Mapper{
+		registry: newRegistry(),
+		config:   &config{},
 	}
+	for _, opt := range opts {
+		opt(m.config)
+	}
+	return m
 }
 
 // Register registers a mapping function for converting from type S to type D.
 // The function will be stored in the mapper's registry and can be used by Map and MapSlice.
 // If a mapping for the same type pair already exists, it will be overwritten.
 //
+// Keying rule: one level of pointer indirection is stripped from both S and D, exactly as
+// Map does when looking a mapping up. func(Person) PersonDTO, func(*Person) PersonDTO,
+// func(Person) *PersonDTO and func(*Person) *PersonDTO are all stored under
+// Person -> PersonDTO, so any of them serves Map[Person, PersonDTO], Map[*Person, *PersonDTO]
+// and the mixed forms, and registering one replaces another. Taking *S avoids copying a
+// large source struct on every call.
+//
+// For large structs, register func(*S) *D. Map[*S, *D] then calls it directly with the
+// caller's pointer and returns its result, so no struct is copied at all, whereas
+// func(S) D copies the source into the call and the destination out of it. Map[S, D]
+// calls it directly too, passing a pointer to its copy of the source and dereferencing
+// the result, with a nil result mapping to the zero value. Other combinations of
+// registered and requested forms go through reflection.
+//
+// Anonymous struct types such as struct{ Name string } can be registered like any other
+// type. Keys follow Go type identity, which can be surprising for them: two literals with
+// the same field names, types and tags are the same type, even when declared in different
+// functions, while a difference in tags, or unexported fields declared in different
+// packages, makes them distinct types with separate registrations.
+//
+// Any value of type func(S) D is accepted, including bound method values such as
+// conv.Convert and closures that capture the mapper to map nested fields. They are all
+// called through the same fast path as plain functions.
+//
 // Type Parameters:
 //   - S: Source type (input type for the mapping function)
 //   - D: Destination type (output type for the mapping function)
@@ -63,6 +230,10 @@ func New() Mapper {
 //   - m: The mapper instance to register the function with
 //   - fn: The mapping function that converts from S to D
 //
+// Panics:
+//   - If fn is nil, with an error wrapping ErrNilMappingFunc. Failing at registration is
+//     far easier to debug than failing at the first Map call.
+//
 // Example:
 //
 //	mapper := New()
@@ -71,11 +242,15 @@ func New() Mapper {
 //	    return PersonDTO{Name: p.FirstName + " " + p.LastName}
 //	})
 func Register[S any, D any](m Mapper, fn func(S) D) {
-	key := typePair{
-		src: reflect.TypeOf((*S)(nil)).Elem(),
-		dst: reflect.TypeOf((*D)(nil)).Elem(),
+	if fn == nil {
+		panic(nilFuncError(typePair{src: typeOf[S](), dst: typeOf[D]()}))
 	}
-	m.registry[key] = fn
+	registerKind(m, fn, manualEntry)
+}
+
+// nilFuncError builds the error reported when a nil function is registered for key.
+func nilFuncError(key typePair) error {
+	return fmt.Errorf("%w for %s->%s", ErrNilMappingFunc, key.src, key.dst)
 }
 
 // Map executes a registered mapping function to convert a value from type S to type D.
@@ -91,14 +266,22 @@ func Register[S any, D any](m Mapper, fn func(S) D) {
 //   - src: The source value to be mapped
 //
 // Returns:
-//   - D: The mapped result of type D
-//   - error: ErrNoMapping if no mapping function is registered for the type pair
+//   - D: The mapped result of type D; on error, the zero value, except for a
+//     *NestedMapError, which comes with the partially populated result
+//   - error: ErrNoMapping if no mapping function is registered for the type pair and
+//     neither a resolver (see SetResolver) nor a fallback (see SetFallback) supplies
+//     one, ErrSignatureMismatch if the registered function cannot be called with the
+//     source value, ErrUntypedNil if S is an interface type and src is nil, or a
+//     *NestedMapError from an auto-mapping with AutoMapOptions.ContinueOnNestedError
+//
+// Conditional mappings registered with RegisterWhen are tried before the default mapping.
 //
 // Supported mapping combinations:
 //   - Value to Value: T -> U
 //   - Pointer to Pointer: *T -> *U (nil input returns nil output)
 //   - Value to Pointer: T -> *U
 //   - Pointer to Value: *T -> U (nil input returns zero value)
+//   - Value to Interface: T -> I, where the registered function returns the interface I
 //
 // Example:
 //
@@ -122,28 +305,187 @@ func Map[S any, D any](m Mapper, src S) (D, error) {
 	var dst D
 
 	srcType := reflect.TypeOf(src)
-	dstType := reflect.TypeOf(dst)
+	if srcType == nil {
+		return dst, fmt.Errorf("%w to %s", ErrUntypedNil, typeOf[D]())
+	}
 
-	// Get the underlying types for the registry key
-	keySrcType := srcType
-	keyDstType := dstType
+	// Pointer and value forms share the same registry key
+	key := pairOf(srcType, typeOf[D]())
 
-	if keySrcType.Kind() == reflect.Ptr {
-		keySrcType = keySrcType.Elem()
+	fn, ok := matchConditional(m, key, src)
+	if !ok {
+		fn, ok = m.registry.get(key)
 	}
-	if keyDstType.Kind() == reflect.Ptr {
-		keyDstType = keyDstType.Elem()
+	if !ok {
+		var err error
+		if fn, ok, err = resolve(m, key); err != nil {
+			return dst, err
+		}
+	}
+	if !ok {
+		if st := m.config.stats; st != nil {
+			st.misses.Add(1)
+		}
+		if m.config.fallback != nil {
+			return mapFallback[S, D](m, src)
+		}
+		return dst, ErrNoMapping
 	}
 
-	key := typePair{
-		src: keySrcType,
-		dst: keyDstType,
+	if st := m.config.stats; st != nil {
+		st.recordHit(isFastPath[S, D](fn, src))
 	}
 
-	fn, ok := m.registry[key]
+	if def, ok := nilDefaultFor[S, D](m, key, src); ok {
+		return def, nil
+	}
+	dst, err := callMapping[S, D](fn, src)
+	if err != nil {
+		return partialResult[D](err), err
+	}
+	return postProcess(m, src, dst), nil
+}
+
+// partialResult returns the partially populated destination of an auto-mapping that
+// failed with a *NestedMapError, converted to D, or the zero D for any other error.
+func partialResult[D any](err error) D {
+	nested, ok := err.(*NestedMapError)
+	if !ok || !nested.partial.IsValid() || derefType(nested.partial.Type()) != derefType(typeOf[D]()) {
+		var zero D
+		return zero
+	}
+	return valueAs[D](adaptResult(nested.partial, typeOf[D]()))
+}
+
+// lookup returns the mapping function registered for the declared types S and D.
+// It lets helpers that map many values resolve the function once up front.
+func lookup[S any, D any](m Mapper) (any, bool) {
+	fn, ok := m.registry.get(keyOf[S, D]())
+	return fn, ok
+}
+
+// callMapping invokes a registered mapping function for a single value. Functions whose
+// signature is exactly func(S) D, or func(*S) *D for value types S and D, are called
+// directly; every other combination goes through mapWithReflection, whose signature
+// panics are reported as ErrSignatureMismatch. Built-in functions that fail with
+// failMapping have their error returned on both paths.
+func callMapping[S any, D any](fn any, src S) (_ D, err error) {
+	if isFastPath[S, D](fn, src) {
+		defer recoverFailure(&err)
+		if direct, ok := fn.(func(S) D); ok {
+			return direct(src), nil
+		}
+		if dst := fn.(func(*S) *D)(&src); dst != nil {
+			return *dst, nil
+		}
+		var zero D
+		return zero, nil
+	}
+
+	defer recoverSignatureMismatch(typeOf[S](), typeOf[D](), &err)
+	return mapWithReflection[S, D](fn, src), nil
+}
+
+// recoverSignatureMismatch converts a panic raised by the reflect package while calling a
+// registered function, or by resultAs for a result of the wrong type, into an
+// ErrSignatureMismatch error stored in err. A failure raised with failMapping is stored in
+// err as is. Other panics raised by the mapping function itself, including failed type
+// assertions, are not related to its signature and are propagated.
+func recoverSignatureMismatch(src, dst reflect.Type, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	switch v := r.(type) {
+	case mappingFailure:
+		*err = v.err
+		return
+	case *reflect.ValueError, resultTypeError:
+	case string:
+		if !strings.HasPrefix(v, "reflect") {
+			panic(r)
+		}
+	default:
+		panic(r)
+	}
+	*err = fmt.Errorf("%w for %s->%s: %v", ErrSignatureMismatch, src, dst, r)
+}
+
+// resultTypeError is the panic value of resultAs. It is distinct from the
+// *runtime.TypeAssertionError of a failed type assertion, so that recoverSignatureMismatch
+// can tell the mapper's own check from a bug inside the mapping function.
+type resultTypeError struct {
+	result, dst reflect.Type
+}
+
+// String describes the mismatch for the ErrSignatureMismatch error.
+func (e resultTypeError) String() string {
+	return fmt.Sprintf("result %s is not %s", e.result, e.dst)
+}
+
+// resultAs returns the result v of a mapping function called through reflection as D,
+// like valueAs, and panics with a resultTypeError if v does not hold a D.
+func resultAs[D any](v reflect.Value) D {
+	if v.Kind() == reflect.Interface && v.IsNil() {
+		var dst D
+		return dst
+	}
+	dst, ok := v.Interface().(D)
 	if !ok {
-		return dst, ErrNoMapping
+		panic(resultTypeError{result: v.Type(), dst: typeOf[D]()})
+	}
+	return dst
+}
+
+// mappingFailure is the panic value used by failMapping to abort a mapping function.
+type mappingFailure struct {
+	err error
+}
+
+// failMapping aborts the running mapping function so that the Map call, or the slice
+// helper mapping the element, returns err. It lets built-in conversions that can fail,
+// such as parsing a time, keep the plain func(S) D signature.
+func failMapping(err error) {
+	panic(mappingFailure{err: err})
+}
+
+// recoverFailure stores the error of a failMapping panic in err and propagates any other
+// panic.
+func recoverFailure(err *error) {
+	if r := recover(); r != nil {
+		f, ok := r.(mappingFailure)
+		if !ok {
+			panic(r)
+		}
+		*err = f.err
 	}
+}
+
+// isFastPath reports whether fn can be called directly for src, as func(S) D or as
+// func(*S) *D. Nil pointer sources are excluded so that they keep mapping to the zero
+// value without invoking fn.
+func isFastPath[S any, D any](fn any, src S) bool {
+	switch fn.(type) {
+	case func(S) D:
+		if typeOf[S]().Kind() == reflect.Ptr {
+			return !reflect.ValueOf(src).IsNil()
+		}
+		return true
+	case func(*S) *D:
+		// S is not a pointer here, since registered functions never take a **S
+		return true
+	}
+	return false
+}
+
+// mapWithReflection calls fn through reflection, adapting the source and the result
+// between value and pointer forms as required by S and D.
+func mapWithReflection[S any, D any](fn any, src S) D {
+	var dst D
+
+	srcType := reflect.TypeOf(src)
+	dstType := typeOf[D]()
 
 	fnValue := reflect.ValueOf(fn)
 	fnType := fnValue.Type()
@@ -154,7 +496,7 @@ func Map[S any, D any](m Mapper, src S) (D, error) {
 	// Case 1: src is pointer, dst is pointer
 	if srcType.Kind() == reflect.Ptr && dstType.Kind() == reflect.Ptr {
 		if srcValue.IsNil() {
-			return dst, nil // return zero value (nil pointer)
+			return dst // return zero value (nil pointer)
 		}
 
 		var callArg reflect.Value
@@ -170,19 +512,19 @@ func Map[S any, D any](m Mapper, src S) (D, error) {
 
 		if fnType.Out(0).Kind() == reflect.Ptr {
 			// Function returns pointer, we need pointer
-			return result.Interface().(D), nil
+			return resultAs[D](result)
 		} else {
 			// Function returns value, we need pointer - create pointer
 			ptrResult := reflect.New(result.Type())
 			ptrResult.Elem().Set(result)
-			return ptrResult.Interface().(D), nil
+			return resultAs[D](ptrResult)
 		}
 	}
 
 	// Case 2: src is pointer, dst is value
 	if srcType.Kind() == reflect.Ptr && dstType.Kind() != reflect.Ptr {
 		if srcValue.IsNil() {
-			return dst, nil // return zero value
+			return dst // return zero value
 		}
 
 		var callArg reflect.Value
@@ -199,12 +541,12 @@ func Map[S any, D any](m Mapper, src S) (D, error) {
 		if fnType.Out(0).Kind() == reflect.Ptr {
 			// Function returns pointer, we need value - dereference
 			if result.IsNil() {
-				return dst, nil
+				return dst
 			}
-			return result.Elem().Interface().(D), nil
+			return resultAs[D](result.Elem())
 		} else {
 			// Function returns value, we need value
-			return result.Interface().(D), nil
+			return resultAs[D](result)
 		}
 	}
 
@@ -225,12 +567,12 @@ func Map[S any, D any](m Mapper, src S) (D, error) {
 
 		if fnType.Out(0).Kind() == reflect.Ptr {
 			// Function returns pointer, we need pointer
-			return result.Interface().(D), nil
+			return resultAs[D](result)
 		} else {
 			// Function returns value, we need pointer - create pointer
 			ptrResult := reflect.New(result.Type())
 			ptrResult.Elem().Set(result)
-			return ptrResult.Interface().(D), nil
+			return resultAs[D](ptrResult)
 		}
 	}
 
@@ -252,16 +594,74 @@ func Map[S any, D any](m Mapper, src S) (D, error) {
 		if fnType.Out(0).Kind() == reflect.Ptr {
 			// Function returns pointer, we need value - dereference
 			if result.IsNil() {
-				return dst, nil
+				return dst
 			}
-			return result.Elem().Interface().(D), nil
+			return resultAs[D](result.Elem())
 		} else {
 			// Function returns value, we need value
-			return result.Interface().(D), nil
+			return resultAs[D](result)
+		}
+	}
+
+	return dst
+}
+
+// adaptArg converts src to the parameter type in of a mapping function, taking its address
+// or dereferencing it as needed. It reports false for a nil pointer that would have to be
+// dereferenced.
+func adaptArg(in reflect.Type, src reflect.Value) (reflect.Value, bool) {
+	switch {
+	case src.Kind() == reflect.Ptr && in.Kind() != reflect.Ptr:
+		if src.IsNil() {
+			return reflect.Value{}, false
 		}
+		return src.Elem(), true
+	case src.Kind() != reflect.Ptr && in.Kind() == reflect.Ptr:
+		ptr := reflect.New(src.Type())
+		ptr.Elem().Set(src)
+		return ptr, true
 	}
+	return src, true
+}
 
-	return dst, nil
+// valueAs converts a reflected function result to D. A nil interface result, which
+// cannot be type-asserted, becomes the zero value of D.
+func valueAs[D any](v reflect.Value) D {
+	if v.Kind() == reflect.Interface && v.IsNil() {
+		var dst D
+		return dst
+	}
+	return v.Interface().(D)
+}
+
+// MapLike is like Map, but takes a sample destination value so that Go can infer both type
+// parameters at the call site. The sample is only used for type inference; its value is
+// ignored.
+//
+// Type Parameters:
+//   - S: Source type, inferred from src
+//   - D: Destination type, inferred from the sample
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source value to be mapped
+//   - _: A value of the destination type, such as PersonDTO{} or (*PersonDTO)(nil)
+//
+// Returns:
+//   - D: The mapped result of type D
+//   - error: Any error Map would return
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Person) PersonDTO { return PersonDTO{Name: p.Name} })
+//
+//	dto, err := MapLike(mapper, person, PersonDTO{})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func MapLike[S any, D any](m Mapper, src S, _ D) (D, error) {
+	return Map[S, D](m, src)
 }
 
 // MustMap is like Map but panics if mapping fails.
@@ -308,9 +708,18 @@ func MustMap[S any, D any](m Mapper, src S) D {
 //   - src: The source slice to be mapped
 //
 // Returns:
-//   - D: A new slice containing the mapped elements
+//   - D: A new slice containing the mapped elements. A nil source slice maps to a nil
+//     destination slice and an empty source slice to an empty, non-nil one, so the
+//     distinction survives mapping (for example, JSON null versus []).
 //   - error: ErrNoMapping if no mapping function is registered for the element types,
-//     or an error if source/destination are not slices
+//     or a *SliceTypeError, which wraps ErrSrcAndDestMustBeSlices, if S or D is not a slice
+//
+// Elements of any type, including primitives such as byte, rune, or uint32, are mapped with
+// the function registered for the element pair. There are no built-in conversions: mapping
+// []byte to []rune requires a registered func(byte) rune.
+//
+// S and D may be named slice types, such as type IDs []int. The result has exactly the type
+// D, so it can be asserted back to the named type after being stored in an interface.
 //
 // Supported slice mapping combinations:
 //   - []T -> []U: Value elements to value elements
@@ -318,6 +727,10 @@ func MustMap[S any, D any](m Mapper, src S) D {
 //   - []T -> []*U: Value elements to pointer elements
 //   - []*T -> []U: Pointer elements to value elements (nil elements become zero values)
 //
+// Nil pointer elements map to the default set with RegisterNilDefault instead, if any.
+// The mapping function is never called for a nil element, whether it takes a value or a
+// pointer, so a value-taking function does not need to guard against nil.
+//
 // Example:
 //
 //	mapper := New()
@@ -341,49 +754,89 @@ func MapSlice[S any, D any](m Mapper, src S) (D, error) {
 	var dst D
 
 	srcType := reflect.TypeOf(src)
-	dstType := reflect.TypeOf(dst)
+	dstType := typeOf[D]()
 
 	// Ensure we're working with slices
-	if srcType.Kind() != reflect.Slice || dstType.Kind() != reflect.Slice {
-		return dst, ErrSrcAndDestMustBeSlices
+	if !isSlice(srcType) || !isSlice(dstType) {
+		return dst, &SliceTypeError{Func: "MapSlice", Src: srcType, Dst: dstType}
+	}
+
+	result, err := mapSliceValue(m, reflect.ValueOf(src), dstType)
+	if err != nil {
+		return dst, err
 	}
+	return result.Interface().(D), nil
+}
 
+// mapSliceValue maps every element of the slice srcValue to a new slice of type dstType
+// through the registry. It is the reflection core of MapSlice and is also used by
+// auto-mapping to convert nested slice fields.
+func mapSliceValue(m Mapper, srcValue reflect.Value, dstType reflect.Type) (_ reflect.Value, err error) {
 	// Get element types for registry lookup
-	srcElemType := srcType.Elem()
+	srcElemType := srcValue.Type().Elem()
 	dstElemType := dstType.Elem()
 
 	// Remove pointer indirection for the key
-	keySrcType := srcElemType
-	keyDstType := dstElemType
+	key := pairOf(srcElemType, dstElemType)
 
-	if keySrcType.Kind() == reflect.Ptr {
-		keySrcType = keySrcType.Elem()
-	}
-	if keyDstType.Kind() == reflect.Ptr {
-		keyDstType = keyDstType.Elem()
+	fn, kind, ok := m.registry.lookup(key)
+	if !ok {
+		if st := m.config.stats; st != nil {
+			st.misses.Add(1)
+		}
+		return reflect.Value{}, ErrNoMapping
 	}
 
-	key := typePair{
-		src: keySrcType,
-		dst: keyDstType,
+	if st := m.config.stats; st != nil {
+		st.sliceHits.Add(1)
 	}
 
-	fn, ok := m.registry[key]
-	if !ok {
-		return dst, ErrNoMapping
-	}
+	defer recoverSignatureMismatch(srcElemType, dstElemType, &err)
 
-	fnValue := reflect.ValueOf(fn)
-	fnType := fnValue.Type()
+	if srcValue.IsNil() {
+		return reflect.Zero(dstType), nil
+	}
 
-	srcValue := reflect.ValueOf(src)
 	srcLen := srcValue.Len()
 
 	// Create destination slice
 	dstSlice := reflect.MakeSlice(dstType, srcLen, srcLen)
+	if srcElemType == dstElemType && srcElemType.Kind() != reflect.Pointer && kind == identityEntry {
+		// Mapping each element to itself is a plain copy
+		reflect.Copy(dstSlice, srcValue)
+	} else {
+		fillSlice(fn, srcValue, dstSlice, m.config.nilDefaults[key])
+	}
+	postProcessSlice(m, srcValue, dstSlice)
+
+	return dstSlice, nil
+}
+
+// fillSlice maps every element of srcValue with fn and stores the result at the same
+// index of dstSlice, which must be at least as long as srcValue. Nil pointer elements are
+// mapped to nilDefault if it is valid, and to the zero value otherwise.
+func fillSlice(fn any, srcValue, dstSlice, nilDefault reflect.Value) {
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+	srcElemType := srcValue.Type().Elem()
+	dstElemType := dstSlice.Type().Elem()
+	n := srcValue.Len()
+
+	// Pointer wrappers for arguments and results are carved out of one backing array per
+	// call rather than allocated per element. Every element still gets its own address, so
+	// mapped elements never alias each other, and arguments are copies, so fn cannot
+	// modify the source slice. A retained result keeps its whole batch alive.
+	var args, results reflect.Value
+	if srcElemType.Kind() != reflect.Ptr && fnType.In(0).Kind() == reflect.Ptr {
+		args = reflect.MakeSlice(reflect.SliceOf(srcElemType), n, n)
+		reflect.Copy(args, srcValue)
+	}
+	if dstElemType.Kind() == reflect.Ptr && fnType.Out(0).Kind() != reflect.Ptr {
+		results = reflect.MakeSlice(reflect.SliceOf(fnType.Out(0)), n, n)
+	}
 
 	// Map each element
-	for i := 0; i < srcLen; i++ {
+	for i := 0; i < n; i++ {
 		srcElem := srcValue.Index(i)
 
 		// Handle the four cases based on pointer combinations
@@ -393,10 +846,8 @@ func MapSlice[S any, D any](m Mapper, src S) (D, error) {
 		if srcElemType.Kind() != reflect.Ptr && dstElemType.Kind() != reflect.Ptr {
 			var callArg reflect.Value
 			if fnType.In(0).Kind() == reflect.Ptr {
-				// Function expects pointer, we have value - create pointer
-				ptrArg := reflect.New(srcElem.Type())
-				ptrArg.Elem().Set(srcElem)
-				callArg = ptrArg
+				// Function expects pointer, we have value - use the element's copy
+				callArg = args.Index(i).Addr()
 			} else {
 				// Function expects value, we have value
 				callArg = srcElem
@@ -420,7 +871,7 @@ func MapSlice[S any, D any](m Mapper, src S) (D, error) {
 		// Case 2: []*A -> []*B (pointer to pointer)
 		if srcElemType.Kind() == reflect.Ptr && dstElemType.Kind() == reflect.Ptr {
 			if srcElem.IsNil() {
-				mappedElem = reflect.Zero(dstElemType) // nil pointer
+				mappedElem = nilResult(nilDefault, dstElemType) // nil pointer unless defaulted
 			} else {
 				var callArg reflect.Value
 				if fnType.In(0).Kind() == reflect.Ptr {
@@ -437,10 +888,9 @@ func MapSlice[S any, D any](m Mapper, src S) (D, error) {
 					// Function returns pointer, we need pointer
 					mappedElem = result
 				} else {
-					// Function returns value, we need pointer - create pointer
-					ptrResult := reflect.New(result.Type())
-					ptrResult.Elem().Set(result)
-					mappedElem = ptrResult
+					// Function returns value, we need pointer - point into results
+					results.Index(i).Set(result)
+					mappedElem = results.Index(i).Addr()
 				}
 			}
 		}
@@ -448,7 +898,8 @@ func MapSlice[S any, D any](m Mapper, src S) (D, error) {
 		// Case 3: []*A -> []B (pointer to value)
 		if srcElemType.Kind() == reflect.Ptr && dstElemType.Kind() != reflect.Ptr {
 			if srcElem.IsNil() {
-				mappedElem = reflect.Zero(dstElemType)
+				// fn is skipped, so a value-taking fn never sees a dereferenced nil
+				mappedElem = nilResult(nilDefault, dstElemType)
 			} else {
 				var callArg reflect.Value
 				if fnType.In(0).Kind() == reflect.Ptr {
@@ -479,10 +930,8 @@ func MapSlice[S any, D any](m Mapper, src S) (D, error) {
 		if srcElemType.Kind() != reflect.Ptr && dstElemType.Kind() == reflect.Ptr {
 			var callArg reflect.Value
 			if fnType.In(0).Kind() == reflect.Ptr {
-				// Function expects pointer, we have value - create pointer
-				ptrArg := reflect.New(srcElem.Type())
-				ptrArg.Elem().Set(srcElem)
-				callArg = ptrArg
+				// Function expects pointer, we have value - use the element's copy
+				callArg = args.Index(i).Addr()
 			} else {
 				// Function expects value, we have value
 				callArg = srcElem
@@ -494,17 +943,14 @@ func MapSlice[S any, D any](m Mapper, src S) (D, error) {
 				// Function returns pointer, we need pointer
 				mappedElem = result
 			} else {
-				// Function returns value, we need pointer - create pointer
-				ptrResult := reflect.New(result.Type())
-				ptrResult.Elem().Set(result)
-				mappedElem = ptrResult
+				// Function returns value, we need pointer - point into results
+				results.Index(i).Set(result)
+				mappedElem = results.Index(i).Addr()
 			}
 		}
 
 		dstSlice.Index(i).Set(mappedElem)
 	}
-
-	return dstSlice.Interface().(D), nil
 }
 
 // MustMapSlice is like MapSlice but panics if mapping fails.
@@ -565,17 +1011,47 @@ func MustMapSlice[S any, D any](m Mapper, src S) D {
 //
 //	fmt.Println(Has[int, string](mapper)) // Output: false
 func Has[S any, D any](m Mapper) bool {
-	key := typePair{
-		src: reflect.TypeOf((*S)(nil)).Elem(),
-		dst: reflect.TypeOf((*D)(nil)).Elem(),
-	}
-	_, ok := m.registry[key]
+	_, ok := m.registry.get(keyOf[S, D]())
 	return ok
 }
 
+// Signature returns the type of the function registered for the S -> D pair. Since one
+// registration serves the value and pointer forms of both types, the result shows which
+// variant is actually stored, such as func(*Person) PersonDTO, or func(interface {}) D for
+// a function registered with RegisterMulti. It only inspects the registry.
+//
+// Type Parameters:
+//   - S: Source type of the pair; one level of pointer indirection is ignored
+//   - D: Destination type of the pair; one level of pointer indirection is ignored
+//
+// Parameters:
+//   - m: The mapper instance to inspect
+//
+// Returns:
+//   - reflect.Type: The type of the registered function, or nil if there is none or the
+//     registered value is nil
+//   - bool: true if a mapping is registered for S -> D, like Has
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p *Person) PersonDTO { return PersonDTO{Name: p.Name} })
+//
+//	fnType, ok := Signature[Person, PersonDTO](mapper)
+//	fmt.Println(fnType, ok) // Output: func(*main.Person) main.PersonDTO true
+func Signature[S any, D any](m Mapper) (reflect.Type, bool) {
+	fn, ok := m.registry.get(keyOf[S, D]())
+	if !ok {
+		return nil, false
+	}
+	return reflect.TypeOf(fn), true
+}
+
 // Remove unregisters a mapping function for the specified type pair.
 // After removal, attempting to map between these types will return ErrNoMapping.
 // This operation is safe to call even if no mapping exists for the type pair.
+// Conditional mappings registered with RegisterWhen for the pair are removed as well, and
+// so is a RegisterErrorMap registration.
 //
 // Type Parameters:
 //   - S: Source type to remove mapping for
@@ -597,17 +1073,44 @@ func Has[S any, D any](m Mapper) bool {
 //	_, err := Map[string, int](mapper, "hello")
 //	fmt.Println(err) // Output: no mapping function registered for this type pair
 func Remove[S any, D any](m Mapper) {
-	key := typePair{
-		src: reflect.TypeOf((*S)(nil)).Elem(),
-		dst: reflect.TypeOf((*D)(nil)).Elem(),
+	key := keyOf[S, D]()
+	m.registry.delete(key)
+	delete(m.config.conditions, key)
+	delete(m.config.nilDefaults, key)
+	if dst, ok := m.config.errorMaps[key.src]; ok && derefType(dst) == key.dst {
+		delete(m.config.errorMaps, key.src)
 	}
-	delete(m.registry, key)
+}
+
+// ResetGlobal clears the Global mapper in place, removing every registered mapping,
+// conditional mapping and nil default, and restoring the default settings, such as the
+// fallback and the AutoMapOptions. It gives test suites that register on Global a clean
+// slate. Global is cleared rather than replaced, so Mapper values copied from it earlier
+// observe the reset too.
+//
+// ResetGlobal is meant for test setup and teardown. The registry is swapped atomically, but
+// the settings are not: calling it while other goroutines map or register through Global
+// is a data race.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    code := m.Run()
+//	    mapper.ResetGlobal()
+//	    os.Exit(code)
+//	}
+func ResetGlobal() {
+	Global.registry.reset()
+	*Global.config = config{}
 }
 
 // List returns a slice of strings representing all registered mapping type pairs.
 // Each string is formatted as "SourceType-DestinationType" and can be used for
 // debugging, logging, or displaying available mappings to users.
 //
+// The result is sorted in ascending string order, so it is stable across calls and
+// suitable for golden-file tests and logs.
+//
 // Parameters:
 //   - m: The mapper instance to list mappings from
 //
@@ -626,13 +1129,142 @@ func Remove[S any, D any](m Mapper) {
 //	    fmt.Println("Available mapping:", mapping)
 //	}
 //	// Output:
-//	// Available mapping: string-int
 //	// Available mapping: int-string
 //	// Available mapping: main.Person-main.PersonDTO
+//	// Available mapping: string-int
 func List(m Mapper) []string {
-	keys := make([]string, 0, len(m.registry))
-	for k := range m.registry {
-		keys = append(keys, k.src.String()+"-"+k.dst.String())
+	return ListInto(m, make([]string, 0, len(m.registry.snapshot())))
+}
+
+// ListInto appends the registered mapping type pairs, formatted and sorted like List, to
+// dst and returns the extended slice. Passing a buffer from an earlier call, truncated to
+// zero length, lets callers that list large registries repeatedly reuse it.
+//
+// Parameters:
+//   - m: The mapper instance to list mappings from
+//   - dst: The slice to append to; existing elements are kept and not sorted
+//
+// Returns:
+//   - []string: dst with one "SourceType-DestinationType" entry appended per registered
+//     pair, the appended entries in ascending string order
+//
+// Example:
+//
+//	var buf []string
+//	for range ticker.C {
+//	    buf = ListInto(mapper, buf[:0])
+//	    log.Printf("%d mappings registered", len(buf))
+//	}
+func ListInto(m Mapper, dst []string) []string {
+	entries := m.registry.snapshot()
+	start := len(dst)
+	dst = slices.Grow(dst, len(entries))
+	for k := range entries {
+		dst = append(dst, k.src.String()+"-"+k.dst.String())
+	}
+	sort.Strings(dst[start:])
+	return dst
+}
+
+// ListFunc returns a slice of strings representing all registered mapping type pairs,
+// rendered by the supplied format function. It lets callers choose a representation that
+// suits them, such as short type names or an arrow between source and destination.
+// Like List, the formatted entries are sorted in ascending string order.
+//
+// Parameters:
+//   - m: The mapper instance to list mappings from
+//   - format: Function rendering a single source/destination type pair
+//
+// Returns:
+//   - []string: A sorted slice with one formatted entry per registered type pair
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Person) PersonDTO { return PersonDTO{Name: p.Name} })
+//
+//	mappings := ListFunc(mapper, func(src, dst reflect.Type) string {
+//	    return src.Name() + " → " + dst.Name()
+//	})
+//	fmt.Println(mappings) // Output: [Person → PersonDTO]
+func ListFunc(m Mapper, format func(src, dst reflect.Type) string) []string {
+	entries := m.registry.snapshot()
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, format(k.src, k.dst))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ListByKind returns the registered mapping type pairs of one kind, formatted and sorted
+// like List. Auto-mapped pairs are those registered by RegisterAutoMap and
+// RegisterAutoMapWithOptions, in both directions; every other registration, including
+// RegisterMany and RegisterMulti, is manual. Registering a manual function for an
+// auto-mapped pair makes it manual. Since auto-mapping goes through reflection, listing the
+// auto-mapped pairs is a quick way to find candidates for hand-written mappings.
+//
+// Parameters:
+//   - m: The mapper instance to list mappings from
+//   - auto: true to list auto-mapped pairs, false to list manual ones
+//
+// Returns:
+//   - []string: A sorted slice of "SourceType-DestinationType" entries of the given kind
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(s string) int { return len(s) })
+//	RegisterAutoMap[User, UserDTO](mapper)
+//
+//	fmt.Println(ListByKind(mapper, true))  // Output: [main.User-main.UserDTO main.UserDTO-main.User]
+//	fmt.Println(ListByKind(mapper, false)) // Output: [string-int]
+func ListByKind(m Mapper, auto bool) []string {
+	state := m.registry.state.Load()
+	keys := make([]string, 0, len(state.entries))
+	for k := range state.entries {
+		if (state.kinds[k] == autoEntry) == auto {
+			keys = append(keys, k.src.String()+"-"+k.dst.String())
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Diff compares the registered mapping type pairs of two mappers and returns the pairs
+// present in only one of them, formatted and sorted like List. It helps track down
+// registration differences between environments, such as an init function that runs in
+// one build but not another. Only the pairs are compared, not the registered functions.
+//
+// Parameters:
+//   - a: The first mapper instance
+//   - b: The second mapper instance
+//
+// Returns:
+//   - onlyA: The pairs registered in a but not in b
+//   - onlyB: The pairs registered in b but not in a
+//
+// Example:
+//
+//	prod, staging := New(), New()
+//	Register(prod, func(s string) int { return len(s) })
+//	Register(staging, func(i int) string { return strconv.Itoa(i) })
+//
+//	onlyProd, onlyStaging := Diff(prod, staging)
+//	fmt.Println(onlyProd, onlyStaging) // Output: [string-int] [int-string]
+func Diff(a, b Mapper) (onlyA, onlyB []string) {
+	entriesA, entriesB := a.registry.snapshot(), b.registry.snapshot()
+	return pairsMissing(entriesA, entriesB), pairsMissing(entriesB, entriesA)
+}
+
+// pairsMissing returns the sorted List entries of the pairs in from that are not in other.
+func pairsMissing(from, other map[typePair]any) []string {
+	var keys []string
+	for k := range from {
+		if _, ok := other[k]; !ok {
+			keys = append(keys, k.src.String()+"-"+k.dst.String())
+		}
 	}
+	sort.Strings(keys)
 	return keys
 }
@@ -2,15 +2,28 @@
 package mapper
 
 import (
+	"errors"
+	"fmt"
 	"github.com/jinzhu/copier"
 	"reflect"
 )
 
+// ErrCopierPanic is returned, wrapped, when the jinzhu/copier library panics during an
+// auto-mapping, for instance because a source method it calls to fill a field panics. The
+// error names the source and destination types and the panic value.
+var ErrCopierPanic = errors.New("copier panicked")
+
 // autoMap performs automatic mapping between source and destination types using reflection.
 // It uses the jinzhu/copier library to copy matching fields between structs.
 // If the source and destination are of the same type, it performs a direct assignment for optimization.
+// For anonymous structs this applies only to identical types, not to literals that merely
+// look alike, such as ones differing in field tags.
 //
-// This function is used internally by RegisterAutoMap and is not part of the public API.
+// This function is used internally by RegisterAutoMap; CopyFields is its public,
+// error-reporting counterpart. A registered function has no error result, so copier
+// errors are raised through failMapping: Map and the slice helpers return them as
+// "auto-map S->D: <copier error>", and a copier panic as an error wrapping ErrCopierPanic,
+// along with the zero value.
 //
 // Type Parameters:
 //   - S: Source type to map from
@@ -22,6 +35,56 @@ import (
 // Returns:
 //   - D: The mapped destination value with copied fields
 func autoMap[S any, D any](src S) D {
+	dst, err := copyFields[S, D](src)
+	switch {
+	case errors.Is(err, ErrCopierPanic):
+		failMapping(err)
+	case err != nil:
+		failMapping(fmt.Errorf("auto-map %s->%s: %w", typeOf[S](), typeOf[D](), err))
+	}
+	return dst
+}
+
+// CopyFields copies the fields of src into a new D the way RegisterAutoMap does with
+// default options, without registering anything. It suits one-off conversions where a
+// registration would be overkill, such as in scripts and tests.
+//
+// Fields with matching names and compatible types are copied by the jinzhu/copier library;
+// other destination fields keep their zero value. If S and D are the same type, src is
+// assigned directly. A pointer D receives a newly allocated value, or nil for a nil pointer
+// source. The error copier reports is returned, for instance when map fields have
+// different key types. If copier panics, the panic is recovered and returned as an error
+// wrapping ErrCopierPanic, with the zero value of D.
+//
+// Type Parameters:
+//   - S: Source type to copy from
+//   - D: Destination type to copy to
+//
+// Parameters:
+//   - src: The source value to copy from
+//
+// Returns:
+//   - D: The destination value with the copied fields
+//   - error: The error reported by copier, if any, in which case D holds whatever was
+//     copied, or an error wrapping ErrCopierPanic
+//
+// Example:
+//
+//	dto, err := CopyFields[User, UserDTO](user)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func CopyFields[S any, D any](src S) (D, error) {
+	dst, err := copyFields[S, D](src)
+	if err != nil && !errors.Is(err, ErrCopierPanic) {
+		return dst, fmt.Errorf("copy %s to %s: %w", typeOf[S](), typeOf[D](), err)
+	}
+	return dst, err
+}
+
+// copyFields is the shared implementation of CopyFields and autoMap. It returns copier's
+// error unwrapped, and the zero value of D with an ErrCopierPanic error if copier panics.
+func copyFields[S any, D any](src S) (D, error) {
 	var dst D
 
 	// Fast path: if src and dst are the same type, just assign
@@ -29,14 +92,43 @@ func autoMap[S any, D any](src S) D {
 		anyDst := any(&dst)
 		anySrc := any(&src)
 		reflect.ValueOf(anyDst).Elem().Set(reflect.ValueOf(anySrc).Elem())
-		return dst
+		return dst, nil
+	}
+
+	// copier cannot fill a nil pointer destination, so allocate the value it points to; a
+	// nil pointer source leaves it nil
+	dstValue := reflect.ValueOf(&dst).Elem()
+	if dstValue.Kind() == reflect.Pointer {
+		if srcValue := reflect.ValueOf(src); srcValue.Kind() == reflect.Pointer && srcValue.IsNil() {
+			return dst, nil
+		}
+		dstValue.Set(reflect.New(dstValue.Type().Elem()))
 	}
 
 	// Avoid unnecessary pointer conversions
 	srcPtr := any(&src)
 	dstPtr := any(&dst)
-	_ = copier.Copy(dstPtr, srcPtr)
-	return dst
+	if err := safeCopy(dstPtr, srcPtr, copier.Option{}); err != nil {
+		if errors.Is(err, ErrCopierPanic) {
+			var zero D
+			return zero, err
+		}
+		return dst, err
+	}
+	return dst, nil
+}
+
+// safeCopy calls copier.CopyWithOption, converting a panic into an error wrapping
+// ErrCopierPanic that names the types involved. dst is a pointer; src is a value or a
+// pointer.
+func safeCopy(dst, src any, opt copier.Option) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w copying %s to %s: %v", ErrCopierPanic,
+				derefType(reflect.TypeOf(src)), reflect.TypeOf(dst).Elem(), r)
+		}
+	}()
+	return copier.CopyWithOption(dst, src, opt)
 }
 
 // RegisterAutoMap registers bidirectional automatic mapping functions for types S and D.
@@ -46,6 +138,47 @@ func autoMap[S any, D any](src S) D {
 // The automatic mapping uses the jinzhu/copier library, which copies fields with matching
 // names and compatible types. This is convenient for mapping between similar structs
 // but comes with a performance cost compared to manually registered functions.
+// If copier fails, for instance on map fields with different key types, Map returns an
+// error "auto-map S->D: <copier error>" that wraps copier's error, rather than a partly
+// filled value.
+//
+// Slice fields whose element types differ, such as Members []Person and Members []PersonDTO,
+// are mapped element by element through the mapper when a mapping for the element types is
+// registered. The lookup happens at mapping time, so the element mapping may be registered
+// before or after the auto-mapping. An element mapping that fails fails the auto-mapping
+// with its error. Likewise, struct fields of differing types, such as Data Person and
+// Data PersonDTO, are mapped through the mapper when a mapping between the two struct
+// types is registered, and copied by copier otherwise.
+//
+// Interface fields, such as Payload any on both sides, are mapped by the dynamic type of
+// their value: a *Person payload becomes a *PersonDTO when exactly one mapping from Person
+// has a destination that the field's interface type accepts. Otherwise the value is copied
+// as-is.
+//
+// Fields of embedded structs are matched as if declared on the outer struct, whether the
+// struct is embedded by value or by pointer. A nil embedded pointer in the source, such as
+// a nil *BaseInfo, leaves the destination's BaseInfo, or *BaseInfo, at its zero value; a
+// non-nil one is copied into a new value, never shared with the source. An embedded value
+// mapped to an embedded pointer always yields a newly allocated, non-nil pointer.
+//
+// Self-referential types, such as a Node with Parent *Node and Children []*Node fields, are
+// walked while tracking visited pointers, so cycles terminate and a node reached twice is
+// mapped once and shared in the result. Register the pointer types, as in
+// RegisterAutoMap[*Node, *NodeDTO], so that back-pointers to the root resolve to the
+// returned root as well; a root mapped by value is returned as a copy, and back-pointers
+// to it resolve to a separate mapped node. Types that refer to each other, such as an
+// Author with Books []*Book and a Book with Author *Author, are walked the same way, with
+// visited pointers shared across the types. The fields leading into such a cycle are
+// matched by the auto-mapping's own options, and mappings registered for their types are
+// not consulted.
+//
+// Only structs and pointers to structs can be auto-mapped, apart from identical types which
+// are simply assigned. Conversions between other types, such as string to int, must be
+// registered explicitly with Register.
+//
+// Fields are matched according to the mapper's default AutoMapOptions, which can be set
+// with WithAutoMapOptions or a Builder; use RegisterAutoMapWithOptions to override them
+// for a single pair.
 //
 // Performance Note: AutoMap functions are approximately 50x slower than manually
 // registered mapping functions (~1600ns vs ~25ns per operation) due to reflection overhead.
@@ -87,17 +220,87 @@ func autoMap[S any, D any](src S) D {
 //	    log.Fatal(err)
 //	}
 //	fmt.Printf("Mapped back: %+v\n", backToUser)
+//
+// Panics:
+//   - If S and D are different types and either is not a struct or a pointer to a struct,
+//     with an error wrapping ErrAutoMapUnsupported
+//   - If a value in the mapper's AutoMapOptions.Defaults cannot be assigned to the field it
+//     names, with an error wrapping ErrDefaultType
+//   - If a path in the mapper's AutoMapOptions.FlattenPaths is invalid for S or D, with an
+//     error wrapping ErrFlattenPath
 func RegisterAutoMap[S any, D any](m Mapper) {
-	key := typePair{
-		src: reflect.TypeOf((*S)(nil)).Elem(),
-		dst: reflect.TypeOf((*D)(nil)).Elem(),
-	}
-	m.registry[key] = autoMap[S, D]
+	mustAutoMappable(typeOf[S](), typeOf[D]())
+
+	forward := autoMapWithOptions[S, D](m, m.config.autoMap)
 
 	// reverse mapping
-	key = typePair{
-		src: reflect.TypeOf((*D)(nil)).Elem(),
-		dst: reflect.TypeOf((*S)(nil)).Elem(),
+	backward := autoMapWithOptions[D, S](m, m.config.autoMap)
+	m.registry.update(func(entries map[typePair]any, kinds map[typePair]entryKind) {
+		entries[keyOf[S, D]()] = forward
+		entries[keyOf[D, S]()] = backward
+		kinds[keyOf[S, D]()] = autoEntry
+		kinds[keyOf[D, S]()] = autoEntry
+	})
+}
+
+// mustAutoMappable panics if src and dst cannot be auto-mapped. Identical types are
+// assigned directly; any other pair must consist of structs or pointers to structs.
+func mustAutoMappable(src, dst reflect.Type) {
+	if src == dst {
+		return
 	}
-	m.registry[key] = autoMap[D, S]
+	if derefType(src).Kind() == reflect.Struct && derefType(dst).Kind() == reflect.Struct {
+		return
+	}
+	panic(fmt.Errorf("%w, got %s->%s", ErrAutoMapUnsupported, src, dst))
+}
+
+// RegisterAutoMapValues registers bidirectional automatic mapping functions between the
+// types of srcSample and dstSample, like RegisterAutoMap. It suits configuration-driven
+// setups where types are held as any, and types that are awkward to spell as type
+// arguments. Only the types of the samples are used, never their values.
+//
+// Pointer samples register mapping functions taking and returning pointers, such as
+// func(*User) UserDTO, which share their registry keys with the value forms as usual.
+// The registered functions are built with reflection, so calling them adds some overhead
+// on top of the auto-mapping itself.
+//
+// Parameters:
+//   - m: The mapper instance to register the automatic mapping functions with
+//   - srcSample: A value of the source type, or a pointer to one
+//   - dstSample: A value of the destination type, or a pointer to one
+//
+// Returns:
+//   - error: An error wrapping ErrAutoMapUnsupported if either sample is not a struct or a
+//     pointer to a struct, in which case nothing is registered
+//
+// Example:
+//
+//	pairs := [][2]any{{User{}, UserDTO{}}, {&Order{}, OrderDTO{}}}
+//	for _, p := range pairs {
+//	    if err := RegisterAutoMapValues(mapper, p[0], p[1]); err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+//
+// Panics:
+//   - As RegisterAutoMap does, for instance if the mapper's AutoMapOptions.Defaults do not
+//     fit a registered type
+func RegisterAutoMapValues(m Mapper, srcSample, dstSample any) error {
+	srcType, dstType := reflect.TypeOf(srcSample), reflect.TypeOf(dstSample)
+	for _, t := range []reflect.Type{srcType, dstType} {
+		if t == nil || derefType(t).Kind() != reflect.Struct {
+			return fmt.Errorf("%w: %s->%s", ErrAutoMapUnsupported, srcType, dstType)
+		}
+	}
+
+	forward := autoMapValue(m, srcType, dstType, m.config.autoMap).Interface()
+	backward := autoMapValue(m, dstType, srcType, m.config.autoMap).Interface()
+	m.registry.update(func(entries map[typePair]any, kinds map[typePair]entryKind) {
+		entries[pairOf(srcType, dstType)] = forward
+		entries[pairOf(dstType, srcType)] = backward
+		kinds[pairOf(srcType, dstType)] = autoEntry
+		kinds[pairOf(dstType, srcType)] = autoEntry
+	})
+	return nil
 }
@@ -234,3 +234,27 @@ func BenchmarkAutoMapStructSizes(b *testing.B) {
 		}
 	})
 }
+
+// BenchmarkAutoMapInterfaceFieldLargeRegistry measures auto-mapping an interface field on a
+// registry of 5000 unrelated pairs
+func BenchmarkAutoMapInterfaceFieldLargeRegistry(b *testing.B) {
+	type Envelope struct {
+		ID      int
+		Payload any
+	}
+	type EnvelopeDTO struct {
+		ID      int
+		Payload any
+	}
+
+	mapper := largeRegistry(5000)
+	Register(mapper, personToDTO)
+	RegisterAutoMap[Envelope, EnvelopeDTO](mapper)
+	src := Envelope{ID: 1, Payload: &Person{Name: "Alice", Age: 20}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Map[Envelope, EnvelopeDTO](mapper, src)
+	}
+}
@@ -0,0 +1,1159 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/copier"
+)
+
+// AutoMapOptions configures how RegisterAutoMapWithOptions builds the field correspondence
+// between two struct types. The zero value copies whole structs with the jinzhu/copier
+// library, which matches field names case-insensitively. Setting any option switches to a
+// field-by-field plan computed at registration, where names must match exactly unless
+// CaseInsensitive is set.
+type AutoMapOptions struct {
+	// MatchByJSONTag matches fields by the name in their `json` tag instead of their Go
+	// field name. Tag options such as ",omitempty" are ignored, fields tagged `json:"-"`
+	// are skipped, and fields without a json tag fall back to their Go field name, as in
+	// encoding/json. This is the usual choice when mapping to and from external API types.
+	// It is shorthand for TagKey "json".
+	MatchByJSONTag bool
+
+	// TagKey matches fields by the name in the struct tag with this key, following the
+	// same rules as MatchByJSONTag. It takes precedence over MatchByJSONTag.
+	TagKey string
+
+	// IgnoreFields lists Go field names that are never copied, on either side.
+	IgnoreFields []string
+
+	// CaseInsensitive matches field names regardless of case.
+	CaseInsensitive bool
+
+	// UseSetters fills destinations that encapsulate their state, such as a DTO with an
+	// unexported name field and a SetName(string) method. A source field with no matching
+	// destination field is passed to the destination's Set<FieldName> method, named after
+	// the source's Go field name, if it takes exactly one argument the field's value is
+	// assignable to and returns nothing. Pointer receiver methods are found as well. An
+	// exported destination field always takes precedence over a setter.
+	UseSetters bool
+
+	// FailOnUnmapped makes MapInto return an error wrapping ErrUnmappedFields when the
+	// source has fields with no destination field, instead of silently dropping them. It
+	// catches schema drift between a model and its DTO. Ignored and "-" tagged fields do
+	// not count as unmapped. It also makes MapInto return an error wrapping
+	// ErrAmbiguousFields when several fields of the source or of the destination match
+	// the same name, such as ID and Id under case-insensitive matching. Registered
+	// auto-mappings ignore this option.
+	FailOnUnmapped bool
+
+	// Defaults holds values, keyed by Go field name, for destination fields that are still
+	// at their zero value after the copy, such as a Status that should read "active" when
+	// the source has none. A populated field is never overwritten. Keys naming no field of
+	// a destination are skipped, so one map can serve both directions of a pair.
+	Defaults map[string]any
+
+	// FlattenPaths fills destination fields, keyed by Go field name, from nested source
+	// fields given as dotted paths of Go field names, such as "Customer.Name" for a flat
+	// CustomerName field. Pointers along the path are dereferenced; if one is nil, the
+	// destination field is left at its zero value. A flattened field is set after the
+	// regular copy and before Defaults are applied. Keys naming no field of a destination
+	// are skipped, so one map can serve both directions of a pair.
+	FlattenPaths map[string]string
+
+	// ContinueOnNestedError keeps mapping the remaining fields when a nested mapping fails,
+	// such as a slice whose elements cannot be mapped or a field copier cannot convert.
+	// The errors of all failed fields are combined into a *NestedMapError, and the parent
+	// result is partially populated: Map returns it along with the error, with the failed
+	// fields left at their zero value and flatten paths and defaults still applied. It
+	// suits imports that should report every bad field of a record at once. Without it,
+	// the first failure aborts the mapping and Map returns the zero value.
+	ContinueOnNestedError bool
+}
+
+// NestedMapError is the error of an auto-mapping with AutoMapOptions.ContinueOnNestedError
+// whose nested mappings failed. Errs holds the error of each failed field, in field order;
+// errors.Is and errors.As match any of them.
+type NestedMapError struct {
+	Src, Dst reflect.Type
+	Errs     []error
+
+	// partial is the partially populated destination, returned by Map with the error
+	partial reflect.Value
+}
+
+// Error lists the errors of the failed fields.
+func (e *NestedMapError) Error() string {
+	msgs := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("auto-map %s->%s: %d nested mappings failed: %s", e.Src, e.Dst, len(e.Errs), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of the failed fields.
+func (e *NestedMapError) Unwrap() []error {
+	return e.Errs
+}
+
+// ErrDefaultType is the panic value, wrapped, when an AutoMapOptions default cannot be
+// assigned to the destination field it names.
+var ErrDefaultType = errors.New("default value is not assignable to field")
+
+// ErrFlattenPath is the panic value, wrapped, when an AutoMapOptions flatten path does not
+// name a source field or its value cannot be assigned to the destination field.
+var ErrFlattenPath = errors.New("invalid flatten path")
+
+// tagKey returns the struct tag key used to name fields, or "" to use Go field names.
+func (o AutoMapOptions) tagKey() string {
+	if o.TagKey != "" {
+		return o.TagKey
+	}
+	if o.MatchByJSONTag {
+		return "json"
+	}
+	return ""
+}
+
+// usesPlan reports whether the options require field-by-field matching instead of a
+// whole-struct copy.
+func (o AutoMapOptions) usesPlan() bool {
+	return o.tagKey() != "" || len(o.IgnoreFields) > 0 || o.CaseInsensitive || o.UseSetters || o.ContinueOnNestedError
+}
+
+// ignores reports whether the field named name is listed in IgnoreFields.
+func (o AutoMapOptions) ignores(name string) bool {
+	for _, ignored := range o.IgnoreFields {
+		if ignored == name {
+			return true
+		}
+	}
+	return false
+}
+
+// WithAutoMapOptions sets the default AutoMapOptions used by every RegisterAutoMap call on
+// the mapper. RegisterAutoMapWithOptions ignores these defaults and uses its own options.
+// The IgnoreFields slice and the Defaults and FlattenPaths maps are copied, so later
+// changes to them have no effect.
+//
+// Example:
+//
+//	mapper := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true}))
+//	RegisterAutoMap[User, APIUser](mapper) // fields matched by json tag
+func WithAutoMapOptions(opts AutoMapOptions) Option {
+	opts.IgnoreFields = append([]string(nil), opts.IgnoreFields...)
+	opts.Defaults = maps.Clone(opts.Defaults)
+	opts.FlattenPaths = maps.Clone(opts.FlattenPaths)
+	return func(c *config) {
+		c.autoMap = opts
+	}
+}
+
+// WithGlobalIgnore makes every auto-mapping on the mapper skip the given Go field names,
+// such as CreatedAt and UpdatedAt, on either side. It enforces conventions that hold for
+// all types, where listing the fields in each registration's IgnoreFields would be easy to
+// forget. The names are merged with the IgnoreFields of the mapper's AutoMapOptions and of
+// RegisterAutoMapWithOptions calls. Applying the option several times accumulates names.
+//
+// The option applies to the RegisterAutoMap family, MapInto and MapWith. Ignoring a field
+// switches auto-maps with default options to the field plan, which matches names
+// case-insensitively like copier does.
+//
+// Parameters:
+//   - names: The Go field names never copied by auto-maps
+//
+// Example:
+//
+//	mapper := New(WithGlobalIgnore("CreatedAt", "UpdatedAt", "DeletedAt"))
+//	RegisterAutoMap[User, UserDTO](mapper) // timestamps stay zero in both directions
+func WithGlobalIgnore(names ...string) Option {
+	names = slices.Clone(names)
+	return func(c *config) {
+		c.globalIgnore = append(c.globalIgnore, names...)
+	}
+}
+
+// withGlobalIgnore returns opts with the mapper's global ignores added to IgnoreFields.
+// Options that would copy whole structs match names case-insensitively instead, to keep
+// copier's matching when they switch to the field plan.
+func (c *config) withGlobalIgnore(opts AutoMapOptions) AutoMapOptions {
+	if len(c.globalIgnore) == 0 {
+		return opts
+	}
+	if !opts.usesPlan() {
+		opts.CaseInsensitive = true
+	}
+	opts.IgnoreFields = append(slices.Clip(opts.IgnoreFields), c.globalIgnore...)
+	return opts
+}
+
+// RegisterAutoMapWithOptions registers bidirectional automatic mapping functions for types
+// S and D, like RegisterAutoMap, using opts to decide which fields correspond.
+//
+// The field correspondence is computed once at registration time, so the options add no
+// per-call matching cost. Matched fields with assignable types are copied directly; other
+// matched fields are copied with the jinzhu/copier library. Like RegisterAutoMap, it only
+// accepts structs and pointers to structs, or identical types.
+//
+// Type Parameters:
+//   - S: Source type for bidirectional mapping
+//   - D: Destination type for bidirectional mapping
+//
+// Parameters:
+//   - m: The mapper instance to register the automatic mapping functions with
+//   - opts: Options controlling how fields are matched
+//
+// Example:
+//
+//	type User struct {
+//	    FullName string `json:"name"`
+//	    Mail     string `json:"email,omitempty"`
+//	}
+//
+//	type APIUser struct {
+//	    Name  string `json:"name"`
+//	    Email string `json:"email"`
+//	}
+//
+//	mapper := New()
+//	RegisterAutoMapWithOptions[User, APIUser](mapper, AutoMapOptions{MatchByJSONTag: true})
+//
+//	dto, _ := Map[User, APIUser](mapper, User{FullName: "John", Mail: "john@example.com"})
+//	fmt.Println(dto.Name, dto.Email) // Output: John john@example.com
+//
+// Panics:
+//   - If S and D are different types and either is not a struct or a pointer to a struct,
+//     with an error wrapping ErrAutoMapUnsupported
+//   - If a value in opts.Defaults cannot be assigned to the field it names, with an error
+//     wrapping ErrDefaultType
+//   - If a path in opts.FlattenPaths does not name a source field, or names one that
+//     cannot be assigned to its destination field, with an error wrapping ErrFlattenPath
+func RegisterAutoMapWithOptions[S any, D any](m Mapper, opts AutoMapOptions) {
+	mustAutoMappable(typeOf[S](), typeOf[D]())
+
+	forward := autoMapWithOptions[S, D](m, opts)
+	backward := autoMapWithOptions[D, S](m, opts)
+	m.registry.update(func(entries map[typePair]any, kinds map[typePair]entryKind) {
+		entries[keyOf[S, D]()] = forward
+		entries[keyOf[D, S]()] = backward
+		kinds[keyOf[S, D]()] = autoEntry
+		kinds[keyOf[D, S]()] = autoEntry
+	})
+}
+
+// autoMapWithOptions returns the S -> D auto-mapping function for opts, timed against the
+// mapper's auto-map budget if one is set.
+func autoMapWithOptions[S any, D any](m Mapper, opts AutoMapOptions) func(S) D {
+	opts = m.config.withGlobalIgnore(opts)
+	fn := withFlattenPaths(newAutoMap[S, D](m, opts), opts.FlattenPaths)
+	return withAutoMapBudget(m, withDefaults(fn, opts.Defaults))
+}
+
+// flattenField fills the destination field at dst from the source field reached by path,
+// one field index per path segment.
+type flattenField struct {
+	dst     []int
+	path    [][]int
+	convert bool
+}
+
+// withFlattenPaths wraps fn to fill the fields of D named in paths from nested fields of S.
+// Paths for fields D does not have are ignored.
+//
+// Panics:
+//   - If a path does not resolve on S or its field cannot be assigned to the destination
+//     field, with an error wrapping ErrFlattenPath
+func withFlattenPaths[S any, D any](fn func(S) D, paths map[string]string) func(S) D {
+	fields := flattenFields(typeOf[S](), typeOf[D](), paths)
+	if len(fields) == 0 {
+		return fn
+	}
+
+	return func(src S) D {
+		dst, nested := callNested(fn, src)
+		applyFlatten(fields, reflect.ValueOf(&dst).Elem(), reflect.ValueOf(&src).Elem())
+		nested.raise(reflect.ValueOf(dst))
+		return dst
+	}
+}
+
+// flattenFields resolves the flatten paths for fields of dstType from srcType, as
+// described for withFlattenPaths. It returns nil if either type is not a struct or a
+// pointer to a struct.
+func flattenFields(srcType, dstType reflect.Type, paths map[string]string) []flattenField {
+	srcType, dstType = derefType(srcType), derefType(dstType)
+	if len(paths) == 0 || srcType.Kind() != reflect.Struct || dstType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []flattenField
+	for name, path := range paths {
+		f, ok := dstType.FieldByName(name)
+		if !ok || !f.IsExported() || throughPointer(dstType, f.Index) {
+			continue
+		}
+		indices, fieldType, err := resolvePath(srcType, path)
+		if err != nil {
+			panic(fmt.Errorf("%w: %s.%s from %s.%s: %w", ErrFlattenPath, dstType, name, srcType, path, err))
+		}
+		field := flattenField{dst: f.Index, path: indices}
+		switch {
+		case fieldType.AssignableTo(f.Type):
+		case isNumber(fieldType.Kind()) && isNumber(f.Type.Kind()):
+			field.convert = true
+		default:
+			panic(fmt.Errorf("%w: %s.%s is %s, %s.%s is %s", ErrFlattenPath, dstType, name, f.Type, srcType, path, fieldType))
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// applyFlatten sets the flattened fields of the mapped value dst from src. A nil pointer
+// dst is left untouched.
+func applyFlatten(fields []flattenField, dst, src reflect.Value) {
+	if dst.Kind() == reflect.Pointer {
+		if dst.IsNil() {
+			return
+		}
+		dst = dst.Elem()
+	}
+	for _, f := range fields {
+		v, ok := followPath(src, f.path)
+		if !ok {
+			continue
+		}
+		field := dst.FieldByIndex(f.dst)
+		if f.convert {
+			v = v.Convert(field.Type())
+		}
+		field.Set(v)
+	}
+}
+
+// resolvePath returns the field indices of the dotted path of Go field names on the struct
+// type t, dereferencing pointers between segments, along with the type of the last field.
+func resolvePath(t reflect.Type, path string) ([][]int, reflect.Type, error) {
+	var indices [][]int
+	for _, segment := range strings.Split(path, ".") {
+		t = derefType(t)
+		if t.Kind() != reflect.Struct {
+			return nil, nil, fmt.Errorf("%s is not a struct", t)
+		}
+		f, ok := t.FieldByName(segment)
+		if !ok || !f.IsExported() {
+			return nil, nil, fmt.Errorf("%s has no field %s", t, segment)
+		}
+		indices = append(indices, f.Index)
+		t = f.Type
+	}
+	return indices, t, nil
+}
+
+// followPath walks v along path as computed by resolvePath, reporting false if a pointer
+// on the way is nil.
+func followPath(v reflect.Value, path [][]int) (reflect.Value, bool) {
+	for _, index := range path {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		field, err := v.FieldByIndexErr(index)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		v = field
+	}
+	return v, true
+}
+
+// fieldDefault is a default value for the destination field at index.
+type fieldDefault struct {
+	index []int
+	value reflect.Value
+}
+
+// withDefaults wraps fn to set the defaults for fields of D left at their zero value.
+// Defaults naming no field of D are ignored.
+//
+// Panics:
+//   - If a default cannot be assigned to its field, with an error wrapping ErrDefaultType
+func withDefaults[S any, D any](fn func(S) D, defaults map[string]any) func(S) D {
+	fields := defaultFields(typeOf[D](), defaults)
+	if len(fields) == 0 {
+		return fn
+	}
+
+	return func(src S) D {
+		dst, nested := callNested(fn, src)
+		applyDefaults(fields, reflect.ValueOf(&dst).Elem())
+		nested.raise(reflect.ValueOf(dst))
+		return dst
+	}
+}
+
+// defaultFields resolves the defaults for fields of dstType, as described for
+// withDefaults. It returns nil if dstType is not a struct or a pointer to a struct.
+func defaultFields(dstType reflect.Type, defaults map[string]any) []fieldDefault {
+	dstType = derefType(dstType)
+	if len(defaults) == 0 || dstType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []fieldDefault
+	for name, value := range defaults {
+		f, ok := dstType.FieldByName(name)
+		if !ok || !f.IsExported() || throughPointer(dstType, f.Index) {
+			continue
+		}
+		v := reflect.ValueOf(value)
+		switch {
+		case value == nil:
+			continue
+		case v.Type().AssignableTo(f.Type):
+		case isNumber(v.Kind()) && isNumber(f.Type.Kind()):
+			v = v.Convert(f.Type)
+		default:
+			panic(fmt.Errorf("%w: %s.%s is %s, default is %s", ErrDefaultType, dstType, name, f.Type, v.Type()))
+		}
+		fields = append(fields, fieldDefault{index: f.Index, value: v})
+	}
+	return fields
+}
+
+// applyDefaults sets the defaults for fields of the mapped value dst left at their zero
+// value. A nil pointer dst is left untouched.
+func applyDefaults(fields []fieldDefault, dst reflect.Value) {
+	if dst.Kind() == reflect.Pointer {
+		if dst.IsNil() {
+			return
+		}
+		dst = dst.Elem()
+	}
+	for _, f := range fields {
+		if field := dst.FieldByIndex(f.index); field.IsZero() {
+			field.Set(f.value)
+		}
+	}
+}
+
+// newAutoMap builds the S -> D auto-mapping function for opts. With default matching, the
+// whole struct is copied by autoMap and only slice fields whose element types differ,
+// struct fields of differing types and interface fields are then mapped through m.
+// Self-referential types and non-default options use the field plan instead. Types that
+// are not structs use autoMap unchanged.
+func newAutoMap[S any, D any](m Mapper, opts AutoMapOptions) func(S) D {
+	planOpts := opts
+	if !opts.usesPlan() {
+		// Match names like copier does, in case the plan has to replace it below
+		planOpts.CaseInsensitive = true
+	}
+	plan := newFieldPlan(typeOf[S](), typeOf[D](), planOpts)
+	if plan == nil {
+		return autoMap[S, D]
+	}
+
+	// copier would recurse forever on cyclic values of self-referential types, so those
+	// are always mapped by the plan, which tracks visited pointers
+	if !opts.usesPlan() && !plan.recursive() {
+		nested := plan.nestedFields()
+		if len(nested.fields) == 0 {
+			return autoMap[S, D]
+		}
+		return func(src S) D {
+			dst := autoMap[S, D](src)
+			nested.apply(m, reflect.ValueOf(&dst).Elem(), reflect.ValueOf(&src).Elem())
+			return dst
+		}
+	}
+
+	return func(src S) D {
+		var dst D
+		plan.apply(m, reflect.ValueOf(&dst).Elem(), reflect.ValueOf(&src).Elem())
+		return dst
+	}
+}
+
+// autoMapValue is the reflection counterpart of autoMapWithOptions, for types only known
+// at run time. It returns a function value of type func(srcType) dstType that maps like
+// the generic function would, including the flatten paths, defaults and budget of opts and
+// m. srcType and dstType must be structs or pointers to structs.
+func autoMapValue(m Mapper, srcType, dstType reflect.Type, opts AutoMapOptions) reflect.Value {
+	opts = m.config.withGlobalIgnore(opts)
+	planOpts := opts
+	if !opts.usesPlan() {
+		planOpts.CaseInsensitive = true
+	}
+	plan := newFieldPlan(srcType, dstType, planOpts)
+	usePlan := opts.usesPlan() || plan.recursive()
+	nested := plan.nestedFields()
+	flatten := flattenFields(srcType, dstType, opts.FlattenPaths)
+	defaults := defaultFields(dstType, opts.Defaults)
+	budget := m.config.budget
+
+	fnType := reflect.FuncOf([]reflect.Type{srcType}, []reflect.Type{dstType}, false)
+	return reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+		var start time.Time
+		if budget != nil {
+			start = time.Now()
+		}
+
+		// Copy the argument so that it is addressable, as copier and the plan require
+		src := reflect.New(srcType).Elem()
+		src.Set(args[0])
+		dst := reflect.New(dstType).Elem()
+		var failed *NestedMapError
+		if usePlan {
+			failed = catchNested(func() { plan.apply(m, dst, src) })
+		} else {
+			copyValue(dst, src)
+			if len(nested.fields) > 0 {
+				nested.apply(m, dst, src)
+			}
+		}
+		applyFlatten(flatten, dst, src)
+		applyDefaults(defaults, dst)
+		failed.raise(dst)
+
+		if budget != nil {
+			if elapsed := time.Since(start); elapsed > budget.limit {
+				budget.onSlow(srcType, dstType, elapsed)
+			}
+		}
+		return []reflect.Value{dst}
+	})
+}
+
+// callNested calls fn like a wrapped auto-mapping does, except that a *NestedMapError
+// failure is caught and returned along with its partial result, so that the wrapper can
+// finish the result before raising the error again with raise.
+func callNested[S any, D any](fn func(S) D, src S) (dst D, nested *NestedMapError) {
+	nested = catchNested(func() { dst = fn(src) })
+	if nested != nil {
+		dst = valueAs[D](nested.partial)
+	}
+	return dst, nested
+}
+
+// catchNested runs apply and returns the *NestedMapError it fails with, if any. Other
+// failures are raised again.
+func catchNested(apply func()) *NestedMapError {
+	err := tryMapping(apply)
+	if err == nil {
+		return nil
+	}
+	nested, ok := err.(*NestedMapError)
+	if !ok {
+		failMapping(err)
+	}
+	return nested
+}
+
+// raise fails the running mapping with e, carrying partial as its result. It does nothing
+// if e is nil.
+func (e *NestedMapError) raise(partial reflect.Value) {
+	if e == nil {
+		return
+	}
+	e.partial = partial
+	failMapping(e)
+}
+
+// tryMapping runs fn and returns the error of a failMapping panic it raises.
+func tryMapping(fn func()) (err error) {
+	defer recoverFailure(&err)
+	fn()
+	return nil
+}
+
+// copyValue copies src into the addressable dst with copier, like autoMap does for the
+// generic types. A nil pointer src leaves a pointer dst nil.
+func copyValue(dst, src reflect.Value) {
+	if src.Type() == dst.Type() {
+		dst.Set(src)
+		return
+	}
+	if dst.Kind() == reflect.Pointer {
+		if src.Kind() == reflect.Pointer && src.IsNil() {
+			return
+		}
+		dst.Set(reflect.New(dst.Type().Elem()))
+	}
+
+	switch err := safeCopy(dst.Addr().Interface(), src.Addr().Interface(), copier.Option{}); {
+	case errors.Is(err, ErrCopierPanic):
+		failMapping(err)
+	case err != nil:
+		failMapping(fmt.Errorf("auto-map %s->%s: %w", src.Type(), dst.Type(), err))
+	}
+}
+
+// fieldPlan is a precomputed list of field copies between two struct types, followed by
+// setter calls for AutoMapOptions.UseSetters. unmapped lists the Go names of source fields
+// that have no destination field or setter, and ambiguous describes names matched by
+// several fields of the same struct. continueOnError records
+// AutoMapOptions.ContinueOnNestedError.
+type fieldPlan struct {
+	fields          []fieldCopy
+	setters         []fieldSetter
+	unmapped        []string
+	ambiguous       []string
+	continueOnError bool
+}
+
+// fieldSetter passes the source field at index src to the method with index method in the
+// method set of a pointer to the destination struct.
+type fieldSetter struct {
+	src    []int
+	method int
+}
+
+// fieldCopy copies the source field at index src to the destination field at index dst.
+// nestedSlice marks slice fields whose element types differ, nestedStruct struct fields
+// of differing types, and dynamic marks interface fields whose values are mapped by their
+// dynamic type. linked is the plan for fields that may lead back to a struct already being
+// mapped: pointer or slice fields referring to the plan's own source and destination
+// types, for which it is the plan itself, and fields of other struct types that are part
+// of a cycle of types, such as an A with a B *B field and a B with an A *A field.
+type fieldCopy struct {
+	src          []int
+	dst          []int
+	nestedSlice  bool
+	nestedStruct bool
+	dynamic      bool
+	linked       *fieldPlan
+}
+
+// newFieldPlan matches the exported fields of src and dst according to opts. It returns
+// nil if either type is not a struct or a pointer to a struct.
+func newFieldPlan(src, dst reflect.Type, opts AutoMapOptions) *fieldPlan {
+	src, dst = derefType(src), derefType(dst)
+	if src.Kind() != reflect.Struct || dst.Kind() != reflect.Struct {
+		return nil
+	}
+	return newLinkedPlan(src, dst, opts, make(map[typePair]*fieldPlan))
+}
+
+// newLinkedPlan builds the plan between the structs src and dst for newFieldPlan. plans
+// holds the plans built so far for the same root, so that linked fields reuse the plan of
+// a pair already being built rather than recursing forever.
+func newLinkedPlan(src, dst reflect.Type, opts AutoMapOptions, plans map[typePair]*fieldPlan) *fieldPlan {
+	plan := &fieldPlan{continueOnError: opts.ContinueOnNestedError}
+	plans[typePair{src: src, dst: dst}] = plan
+	srcFields := make(map[string][]int)
+	var srcNames []string
+	for _, f := range planFields(src) {
+		if name, ok := fieldName(f, opts); ok {
+			srcFields[name] = f.Index
+			srcNames = append(srcNames, name)
+		}
+	}
+	plan.findAmbiguous("source", src, opts)
+	plan.findAmbiguous("destination", dst, opts)
+	matched := make(map[string]bool, len(srcFields))
+
+	for _, f := range planFields(dst) {
+		name, ok := fieldName(f, opts)
+		if !ok {
+			continue
+		}
+		index, found := srcFields[name]
+		if !found {
+			continue
+		}
+		matched[name] = true
+		srcType := src.FieldByIndex(index).Type
+		field := fieldCopy{
+			src:          index,
+			dst:          f.Index,
+			nestedSlice:  srcType.Kind() == reflect.Slice && f.Type.Kind() == reflect.Slice && srcType.Elem() != f.Type.Elem(),
+			nestedStruct: srcType.Kind() == reflect.Struct && f.Type.Kind() == reflect.Struct && srcType != f.Type,
+			dynamic:      srcType.Kind() == reflect.Interface && f.Type.Kind() == reflect.Interface,
+		}
+		if refersTo(srcType, src) && refersTo(f.Type, dst) && srcType.Kind() == f.Type.Kind() {
+			field.linked = plan
+		} else if s, d, ok := linkedStructs(srcType, f.Type); ok && cyclicType(s) {
+			if field.linked = plans[typePair{src: s, dst: d}]; field.linked == nil {
+				field.linked = newLinkedPlan(s, d, opts, plans)
+			}
+		}
+		plan.fields = append(plan.fields, field)
+	}
+
+	if opts.UseSetters {
+		dstPtr := reflect.PointerTo(dst)
+		for _, name := range srcNames {
+			if matched[name] {
+				continue
+			}
+			if method, ok := findSetter(dstPtr, src.FieldByIndex(srcFields[name]), opts); ok {
+				matched[name] = true
+				plan.setters = append(plan.setters, fieldSetter{src: srcFields[name], method: method})
+			}
+		}
+	}
+
+	for _, name := range srcNames {
+		if !matched[name] {
+			plan.unmapped = append(plan.unmapped, src.FieldByIndex(srcFields[name]).Name)
+		}
+	}
+	return plan
+}
+
+// findSetter returns the index of the Set<FieldName> method of the pointer type ptr that
+// accepts the source field f, as described for AutoMapOptions.UseSetters.
+func findSetter(ptr reflect.Type, f reflect.StructField, opts AutoMapOptions) (int, bool) {
+	want := "Set" + f.Name
+	for i := 0; i < ptr.NumMethod(); i++ {
+		method := ptr.Method(i)
+		if method.Name != want && !(opts.CaseInsensitive && strings.EqualFold(method.Name, want)) {
+			continue
+		}
+		// The receiver is the method type's first argument
+		if mt := method.Type; mt.NumIn() == 2 && mt.NumOut() == 0 && f.Type.AssignableTo(mt.In(1)) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// findAmbiguous records the names under which several fields of the struct t match in
+// opts, such as ID and Id when matching case-insensitively. side names the struct in the
+// recorded description.
+func (p *fieldPlan) findAmbiguous(side string, t reflect.Type, opts AutoMapOptions) {
+	byName := make(map[string][]string)
+	var names []string
+	for _, f := range planFields(t) {
+		name, ok := fieldName(f, opts)
+		if !ok {
+			continue
+		}
+		if _, seen := byName[name]; !seen {
+			names = append(names, name)
+		}
+		byName[name] = append(byName[name], fieldPath(t, f.Index))
+	}
+
+	for _, name := range names {
+		if fields := byName[name]; len(fields) > 1 {
+			p.ambiguous = append(p.ambiguous, fmt.Sprintf("%s fields %s all match %q", side, strings.Join(fields, ", "), name))
+		}
+	}
+}
+
+// refersTo reports whether t is a pointer to target, or a slice of target or of pointers
+// to target.
+func refersTo(t, target reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return t.Elem() == target
+	case reflect.Slice:
+		return derefType(t.Elem()) == target
+	}
+	return false
+}
+
+// linkedStructs returns the struct types that the field types src and dst hold, when both
+// are distinct structs, pointers to them, or slices of either of the same shape.
+func linkedStructs(src, dst reflect.Type) (reflect.Type, reflect.Type, bool) {
+	if src.Kind() == reflect.Slice && dst.Kind() == reflect.Slice {
+		src, dst = src.Elem(), dst.Elem()
+	}
+	if src.Kind() == reflect.Pointer && dst.Kind() == reflect.Pointer {
+		src, dst = src.Elem(), dst.Elem()
+	}
+	ok := src.Kind() == reflect.Struct && dst.Kind() == reflect.Struct && src != dst
+	return src, dst, ok
+}
+
+// cyclicType reports whether a struct type reachable from t through exported fields,
+// pointers, slices, arrays and maps can reach itself again, so that values of t may
+// contain cycles.
+func cyclicType(t reflect.Type) bool {
+	return findCycle(t, make(map[reflect.Type]bool))
+}
+
+// findCycle is cyclicType's depth-first search. walking maps the struct types on the
+// current path to true and those already fully searched to false.
+func findCycle(t reflect.Type, walking map[reflect.Type]bool) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return findCycle(t.Elem(), walking)
+	case reflect.Struct:
+	default:
+		return false
+	}
+
+	if onPath, seen := walking[t]; seen {
+		return onPath
+	}
+	walking[t] = true
+	for _, f := range planFields(t) {
+		if findCycle(f.Type, walking) {
+			return true
+		}
+	}
+	walking[t] = false
+	return false
+}
+
+// recursive reports whether the plan has fields that may lead back to a struct already
+// being mapped.
+func (p *fieldPlan) recursive() bool {
+	for _, f := range p.fields {
+		if f.linked != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// nestedFields returns the part of the plan that maps fields through the registry: slices
+// with differing element types, structs of differing types and interface fields.
+func (p *fieldPlan) nestedFields() *fieldPlan {
+	nested := &fieldPlan{}
+	for _, f := range p.fields {
+		if f.nestedSlice || f.nestedStruct || f.dynamic {
+			nested.fields = append(nested.fields, f)
+		}
+	}
+	return nested
+}
+
+// apply copies the planned fields from src to dst. A nil source pointer leaves dst
+// untouched; a nil destination pointer is allocated first. Slice fields whose element
+// types differ are mapped through m when it has a mapping for the element types, struct
+// fields of differing types when it has a mapping for the field types, and interface
+// fields when it has a mapping for the dynamic type of their value.
+func (p *fieldPlan) apply(m Mapper, dst, src reflect.Value) {
+	(&planWalker{m: m, plan: p}).run(dst, src)
+}
+
+// run applies the walker's plan from src to dst like fieldPlan.apply. If the plan continues
+// on nested errors and some fields failed, it then fails with a *NestedMapError carrying
+// dst as the partial result.
+func (w *planWalker) run(dst, src reflect.Value) {
+	w.walk(dst, src)
+	if len(w.errs) > 0 {
+		nested := &NestedMapError{Src: src.Type(), Dst: dst.Type(), Errs: w.errs}
+		nested.raise(dst)
+	}
+}
+
+// walk applies the walker's plan from src to dst.
+func (w *planWalker) walk(dst, src reflect.Value) {
+	if src.Kind() == reflect.Pointer {
+		if src.IsNil() {
+			return
+		}
+		if dst.Kind() == reflect.Pointer && dst.IsNil() {
+			dst.Set(w.mapLinked(w.plan, src, dst.Type()))
+			return
+		}
+		src = src.Elem()
+	}
+	if dst.Kind() == reflect.Pointer {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		dst = dst.Elem()
+	}
+	w.mapStruct(w.plan, dst, src)
+}
+
+// planWalker applies a field plan to one value graph. Linked fields are walked recursively
+// with their plans, and visited maps each source pointer already seen to its destination
+// pointer of each type, so cycles and shared nodes are mapped once and stay shared in the
+// result. With skipZero set, source fields holding their zero value are not copied. errs
+// collects the field errors of a plan that continues on nested errors.
+type planWalker struct {
+	m        Mapper
+	plan     *fieldPlan
+	visited  map[visitKey]reflect.Value
+	skipZero bool
+	errs     []error
+}
+
+// visitKey identifies a source pointer mapped to the destination type dst. The type is
+// part of the key because a struct and its first field share an address.
+type visitKey struct {
+	ptr uintptr
+	dst reflect.Type
+}
+
+// mapStruct copies the fields of the struct src planned by p into the addressable struct
+// dst, then calls the planned setters on it. Source fields promoted through a nil embedded
+// pointer are skipped, and nil embedded pointers of dst are allocated when one of their
+// fields is copied. A field that fails aborts the mapping, or is recorded in errs and left
+// at its zero value if the plan continues on nested errors.
+func (w *planWalker) mapStruct(p *fieldPlan, dst, src reflect.Value) {
+	for _, f := range p.fields {
+		s, err := src.FieldByIndexErr(f.src)
+		if err != nil || w.skipZero && s.IsZero() {
+			continue
+		}
+		if !w.plan.continueOnError {
+			w.copyField(dst, src, s, f)
+			continue
+		}
+		if err := tryMapping(func() { w.copyField(dst, src, s, f) }); err != nil {
+			w.errs = append(w.errs, err)
+			fieldByIndexAlloc(dst, f.dst).SetZero()
+		}
+	}
+
+	for _, f := range p.setters {
+		s, err := src.FieldByIndexErr(f.src)
+		if err != nil || w.skipZero && s.IsZero() {
+			continue
+		}
+		dst.Addr().Method(f.method).Call([]reflect.Value{s})
+	}
+}
+
+// copyField copies the source field value s to the field of the struct dst planned by f.
+// src is the struct s belongs to.
+func (w *planWalker) copyField(dst, src, s reflect.Value, f fieldCopy) {
+	d := fieldByIndexAlloc(dst, f.dst)
+	if f.linked != nil {
+		d.Set(w.mapLinked(f.linked, s, d.Type()))
+		return
+	}
+	if f.dynamic {
+		if mapped, ok := mapDynamic(w.m, s, d.Type()); ok {
+			d.Set(mapped)
+			return
+		}
+	}
+	if s.Type().AssignableTo(d.Type()) {
+		d.Set(s)
+		return
+	}
+	if f.nestedStruct {
+		if fn, ok := w.m.registry.get(pairOf(s.Type(), d.Type())); ok {
+			mapped, err := mapValue(fn, s, d.Type())
+			if err != nil {
+				failMapping(fmt.Errorf("auto-map %s->%s: field %s: %w", src.Type(), dst.Type(), dst.Type().FieldByIndex(f.dst).Name, err))
+			}
+			d.Set(mapped)
+			return
+		}
+	}
+	if f.nestedSlice {
+		mapped, ok, err := mapNestedSlice(w.m, s, d.Type())
+		if err != nil {
+			failMapping(fmt.Errorf("auto-map %s->%s: field %s: %w", src.Type(), dst.Type(), dst.Type().FieldByIndex(f.dst).Name, err))
+		}
+		if ok {
+			d.Set(mapped)
+			return
+		}
+	}
+	switch err := safeCopy(d.Addr().Interface(), s.Interface(), copier.Option{}); {
+	case errors.Is(err, ErrCopierPanic):
+		failMapping(err)
+	case err != nil:
+		failMapping(fmt.Errorf("auto-map %s->%s: field %s: %w", src.Type(), dst.Type(), dst.Type().FieldByIndex(f.dst).Name, err))
+	}
+}
+
+// mapLinked maps a value of the source type of the plan p, a pointer to it, or a slice of
+// either, to dstType.
+func (w *planWalker) mapLinked(p *fieldPlan, s reflect.Value, dstType reflect.Type) reflect.Value {
+	switch s.Kind() {
+	case reflect.Pointer:
+		if s.IsNil() {
+			return reflect.Zero(dstType)
+		}
+		key := visitKey{ptr: s.Pointer(), dst: dstType}
+		if mapped, ok := w.visited[key]; ok {
+			return mapped
+		}
+		if w.visited == nil {
+			w.visited = make(map[visitKey]reflect.Value)
+		}
+		ptr := reflect.New(dstType.Elem())
+		w.visited[key] = ptr
+		w.mapStruct(p, ptr.Elem(), s.Elem())
+		return ptr
+	case reflect.Slice:
+		if s.IsNil() {
+			return reflect.Zero(dstType)
+		}
+		out := reflect.MakeSlice(dstType, s.Len(), s.Len())
+		for i := 0; i < s.Len(); i++ {
+			out.Index(i).Set(w.mapLinked(p, s.Index(i), dstType.Elem()))
+		}
+		return out
+	default:
+		out := reflect.New(dstType).Elem()
+		w.mapStruct(p, out, s)
+		return out
+	}
+}
+
+// mapNestedSlice maps the slice s to dstType through the registry, reporting false if m
+// has no mapping for the element types, along with the error of a failed element mapping.
+func mapNestedSlice(m Mapper, s reflect.Value, dstType reflect.Type) (reflect.Value, bool, error) {
+	if _, ok := m.registry.get(pairOf(s.Type().Elem(), dstType.Elem())); !ok {
+		return reflect.Value{}, false, nil
+	}
+	if s.IsNil() {
+		return reflect.Zero(dstType), true, nil
+	}
+
+	mapped, err := mapSliceValue(m, s, dstType)
+	if err != nil {
+		return reflect.Value{}, false, err
+	}
+	return mapped, true, nil
+}
+
+// mapDynamic maps the value held by the interface s through the registry, for storing in
+// an interface of type dstType. The mapping used is the one registered from the dynamic
+// type of the value whose destination, in the value's pointer or value form, implements
+// dstType. It reports false if s is nil or there is not exactly one such mapping, in which
+// case the value is copied as-is. A mapping that fails aborts the auto-mapping through
+// failMapping. The choice is cached until the registry changes, so the registry is
+// scanned once per pair of dynamic and interface types rather than on every call.
+func mapDynamic(m Mapper, s reflect.Value, dstType reflect.Type) (reflect.Value, bool) {
+	if s.IsNil() {
+		return reflect.Value{}, false
+	}
+
+	elem := s.Elem()
+	match := m.registry.dynamicMatch(elem.Type(), dstType)
+	if match.fn == nil {
+		return reflect.Value{}, false
+	}
+
+	mapped, err := mapValue(match.fn, elem, match.target)
+	if err != nil {
+		failMapping(fmt.Errorf("auto-map %s->%s: %w", elem.Type(), match.target, err))
+	}
+	return mapped, true
+}
+
+// findDynamic scans entries for the mapping of an interface value of type value stored in
+// an interface of type iface, as described for mapDynamic.
+func findDynamic(entries map[typePair]any, value, iface reflect.Type) dynamicMapping {
+	srcType := derefType(value)
+	var match dynamicMapping
+	for k, f := range entries {
+		if k.src != srcType {
+			continue
+		}
+		t := k.dst
+		if value.Kind() == reflect.Pointer && t.Kind() != reflect.Interface {
+			t = reflect.PointerTo(t)
+		}
+		if !t.Implements(iface) {
+			continue
+		}
+		if match.fn != nil {
+			return dynamicMapping{}
+		}
+		match = dynamicMapping{fn: f, target: t}
+	}
+	return match
+}
+
+// mappableFields returns the exported fields of t, including fields promoted from embedded
+// structs. Fields promoted through embedded pointers are excluded because reaching them
+// may require dereferencing a nil pointer.
+func mappableFields(t reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || (f.Anonymous && derefType(f.Type).Kind() == reflect.Struct) {
+			continue
+		}
+		if len(f.Index) > 1 && throughPointer(t, f.Index) {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// planFields returns the fields a field plan matches for the struct t: those returned by
+// mappableFields, plus fields promoted through embedded struct pointers.
+func planFields(t reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+	for _, f := range reflect.VisibleFields(t) {
+		if f.IsExported() && !(f.Anonymous && derefType(f.Type).Kind() == reflect.Struct) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// fieldByIndexAlloc returns the field of the struct v at index like FieldByIndex, allocating
+// any nil embedded pointer on the way to it. v must be addressable.
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	if len(index) == 1 {
+		return v.Field(index[0])
+	}
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// throughPointer reports whether reaching the field at index from t dereferences a pointer.
+func throughPointer(t reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		t = t.Field(i).Type
+		if t.Kind() == reflect.Pointer {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldName returns the name used to match f under opts, and false if f must be skipped.
+func fieldName(f reflect.StructField, opts AutoMapOptions) (string, bool) {
+	if opts.ignores(f.Name) {
+		return "", false
+	}
+
+	name := f.Name
+	if key := opts.tagKey(); key != "" {
+		if tag, ok := f.Tag.Lookup(key); ok {
+			tagName, _, _ := strings.Cut(tag, ",")
+			switch tagName {
+			case "-":
+				return "", false
+			case "":
+			default:
+				name = tagName
+			}
+		}
+	}
+
+	if opts.CaseInsensitive {
+		name = strings.ToLower(name)
+	}
+	return name, true
+}
+
+// derefType returns the element type of t if t is a pointer, and t otherwise.
+func derefType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,564 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/copier"
+)
+
+// TestRegisterAutoMapWithOptions tests auto-mapping configured through AutoMapOptions
+func TestRegisterAutoMapWithOptions(t *testing.T) {
+	type User struct {
+		FullName string `json:"name"`
+		Mail     string `json:"email,omitempty"`
+		Password string `json:"-"`
+		Age      int
+	}
+	type APIUser struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		Password string `json:"password"`
+		Age      int64
+	}
+
+	opts := AutoMapOptions{MatchByJSONTag: true}
+
+	t.Run("MatchFieldsByJSONTag", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[User, APIUser](mapper, opts)
+
+		user := User{FullName: "John", Mail: "john@example.com", Age: 30}
+		dto, err := Map[User, APIUser](mapper, user)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto.Name != "John" || dto.Email != "john@example.com" {
+			t.Errorf("Expected name and email to be matched by json tag, got %+v", dto)
+		}
+		if dto.Age != 30 {
+			t.Errorf("Expected untagged field to match by name, got %d", dto.Age)
+		}
+	})
+
+	t.Run("SkipDashTag", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[User, APIUser](mapper, opts)
+
+		dto, err := Map[User, APIUser](mapper, User{Password: "secret"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto.Password != "" {
+			t.Errorf("Expected field tagged json:\"-\" to be skipped, got %q", dto.Password)
+		}
+
+		user, err := Map[APIUser, User](mapper, APIUser{Password: "secret"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if user.Password != "" {
+			t.Errorf("Expected field tagged json:\"-\" to be skipped, got %q", user.Password)
+		}
+	})
+
+	t.Run("ReverseMapping", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[User, APIUser](mapper, opts)
+
+		user, err := Map[APIUser, User](mapper, APIUser{Name: "Jane", Email: "jane@example.com"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if user.FullName != "Jane" || user.Mail != "jane@example.com" {
+			t.Errorf("Expected {Jane jane@example.com}, got %+v", user)
+		}
+	})
+
+	t.Run("PointerTypes", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[User, APIUser](mapper, opts)
+
+		dto, err := Map[*User, *APIUser](mapper, &User{FullName: "John"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto == nil || dto.Name != "John" {
+			t.Errorf("Expected pointer to DTO named John, got %+v", dto)
+		}
+	})
+
+	t.Run("EmbeddedFields", func(t *testing.T) {
+		type Audit struct {
+			CreatedBy string `json:"created_by"`
+		}
+		type Record struct {
+			Audit
+			ID int `json:"id"`
+		}
+		type APIRecord struct {
+			Identifier int    `json:"id"`
+			Author     string `json:"created_by"`
+		}
+
+		mapper := New()
+		RegisterAutoMapWithOptions[Record, APIRecord](mapper, opts)
+
+		dto, err := Map[Record, APIRecord](mapper, Record{Audit: Audit{CreatedBy: "admin"}, ID: 7})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto.Identifier != 7 || dto.Author != "admin" {
+			t.Errorf("Expected {7 admin}, got %+v", dto)
+		}
+	})
+
+	t.Run("ZeroOptionsMatchByName", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[User, APIUser](mapper, AutoMapOptions{})
+
+		dto, err := Map[User, APIUser](mapper, User{FullName: "John", Password: "secret"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto.Name != "" || dto.Password != "secret" {
+			t.Errorf("Expected fields to be matched by Go name, got %+v", dto)
+		}
+	})
+}
+
+// TestAutoMapDefaults tests default values for destination fields left unset by auto-mapping
+func TestAutoMapDefaults(t *testing.T) {
+	type Account struct {
+		Name   string
+		Status string
+	}
+	type AccountDTO struct {
+		Name    string
+		Status  string
+		Plan    string
+		Retries int
+	}
+	defaults := map[string]any{"Status": "active", "Plan": "free", "Retries": 3}
+
+	t.Run("DefaultsUnsetFields", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[Account, AccountDTO](mapper, AutoMapOptions{Defaults: defaults})
+
+		got, err := Map[Account, AccountDTO](mapper, Account{Name: "Alice"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := AccountDTO{Name: "Alice", Status: "active", Plan: "free", Retries: 3}
+		if got != want {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	})
+
+	t.Run("PopulatedFieldIsKept", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[Account, AccountDTO](mapper, AutoMapOptions{Defaults: defaults})
+
+		got, err := Map[Account, AccountDTO](mapper, Account{Name: "Alice", Status: "suspended"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Status != "suspended" {
+			t.Errorf("Expected suspended, got %q", got.Status)
+		}
+	})
+
+	t.Run("ReverseDirectionAndPointers", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{Defaults: defaults}))
+		RegisterAutoMap[Account, AccountDTO](mapper)
+
+		back, err := Map[AccountDTO, Account](mapper, AccountDTO{Name: "Alice"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if back.Status != "active" {
+			t.Errorf("Expected active, got %q", back.Status)
+		}
+
+		got, err := Map[*Account, *AccountDTO](mapper, &Account{Name: "Alice"})
+		if err != nil || got == nil || got.Plan != "free" {
+			t.Errorf("Expected pointer with default plan, got %+v (err: %v)", got, err)
+		}
+
+		got, err = Map[*Account, *AccountDTO](mapper, nil)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil for nil source, got %+v (err: %v)", got, err)
+		}
+	})
+
+	t.Run("MismatchedTypePanics", func(t *testing.T) {
+		mapper := New()
+
+		defer func() {
+			err, ok := recover().(error)
+			if !ok || !errors.Is(err, ErrDefaultType) {
+				t.Errorf("Expected panic with ErrDefaultType, got %v", err)
+			}
+		}()
+
+		RegisterAutoMapWithOptions[Account, AccountDTO](mapper, AutoMapOptions{Defaults: map[string]any{"Retries": "three"}})
+	})
+}
+
+// TestAutoMapFlattenPaths tests filling flat destination fields from nested source fields
+func TestAutoMapFlattenPaths(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  int32
+	}
+	type Customer struct {
+		Name    string
+		Address *Address
+	}
+	type Order struct {
+		ID       int
+		Customer Customer
+	}
+	type OrderRow struct {
+		ID           int
+		CustomerName string
+		CustomerCity string
+		CustomerZip  int
+	}
+	paths := map[string]string{
+		"CustomerName": "Customer.Name",
+		"CustomerCity": "Customer.Address.City",
+		"CustomerZip":  "Customer.Address.Zip",
+	}
+
+	t.Run("NestedPaths", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[Order, OrderRow](mapper, AutoMapOptions{FlattenPaths: paths})
+
+		order := Order{ID: 7, Customer: Customer{Name: "Alice", Address: &Address{City: "Hanoi", Zip: 100000}}}
+		got, err := Map[Order, OrderRow](mapper, order)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := OrderRow{ID: 7, CustomerName: "Alice", CustomerCity: "Hanoi", CustomerZip: 100000}
+		if got != want {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	})
+
+	t.Run("NilIntermediateLeavesZero", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[Order, OrderRow](mapper, AutoMapOptions{FlattenPaths: paths})
+
+		got, err := Map[Order, OrderRow](mapper, Order{ID: 7, Customer: Customer{Name: "Alice"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := OrderRow{ID: 7, CustomerName: "Alice"}
+		if got != want {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	})
+
+	t.Run("MapperDefaultsAndReverseDirection", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{FlattenPaths: paths}))
+		RegisterAutoMap[Order, OrderRow](mapper)
+
+		got, err := Map[Order, OrderRow](mapper, Order{Customer: Customer{Name: "Bob"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.CustomerName != "Bob" {
+			t.Errorf("Expected Bob, got %q", got.CustomerName)
+		}
+
+		back, err := Map[OrderRow, Order](mapper, OrderRow{ID: 3, CustomerName: "Bob"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if back.ID != 3 || back.Customer.Name != "" {
+			t.Errorf("Expected only ID to be mapped back, got %+v", back)
+		}
+	})
+
+	t.Run("InvalidPathPanics", func(t *testing.T) {
+		for name, path := range map[string]string{
+			"CustomerName": "Customer.Nickname",
+			"CustomerCity": "ID.City",
+			"CustomerZip":  "Customer.Name",
+		} {
+			func() {
+				defer func() {
+					err, ok := recover().(error)
+					if !ok || !errors.Is(err, ErrFlattenPath) {
+						t.Errorf("Expected panic with ErrFlattenPath for %s, got %v", path, err)
+					}
+				}()
+				RegisterAutoMapWithOptions[Order, OrderRow](New(), AutoMapOptions{FlattenPaths: map[string]string{name: path}})
+			}()
+		}
+	})
+}
+
+// setterDTO exposes its state through setters only; age has no setter
+type setterDTO struct {
+	name  string
+	email string
+	age   int
+	Level int
+	calls int
+}
+
+func (d *setterDTO) SetName(name string) { d.name = name; d.calls++ }
+
+func (d *setterDTO) SetEmail(email string) { d.email = email; d.calls++ }
+
+// SetLevel is shadowed by the exported Level field
+func (d *setterDTO) SetLevel(level int) { d.Level = -level }
+
+// SetAge does not match: it takes the wrong type
+func (d *setterDTO) SetAge(age string) { d.age = len(age) }
+
+// TestAutoMapSetters tests filling destinations through Set<FieldName> methods
+func TestAutoMapSetters(t *testing.T) {
+	type User struct {
+		Name  string
+		Email string
+		Age   int
+		Level int
+	}
+
+	t.Run("CallsSetters", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[User, setterDTO](mapper, AutoMapOptions{UseSetters: true})
+
+		dto, err := Map[User, *setterDTO](mapper, User{Name: "John", Email: "john@example.com", Age: 30, Level: 2})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto.name != "John" || dto.email != "john@example.com" || dto.calls != 2 {
+			t.Errorf("Expected both setters to be called once, got %+v", *dto)
+		}
+		if dto.age != 0 {
+			t.Errorf("Expected field without a matching setter to stay zero, got %d", dto.age)
+		}
+		if dto.Level != 2 {
+			t.Errorf("Expected exported field to take precedence over its setter, got %d", dto.Level)
+		}
+	})
+
+	t.Run("CaseInsensitive", func(t *testing.T) {
+		type Lower struct {
+			NAME string
+		}
+		mapper := New()
+		RegisterAutoMapWithOptions[Lower, setterDTO](mapper, AutoMapOptions{UseSetters: true, CaseInsensitive: true})
+
+		dto, err := Map[Lower, setterDTO](mapper, Lower{NAME: "John"})
+		if err != nil || dto.name != "John" {
+			t.Errorf("Expected SetName to match NAME, got %+v (err: %v)", dto, err)
+		}
+	})
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[User, setterDTO](mapper, AutoMapOptions{CaseInsensitive: true})
+
+		dto, err := Map[User, setterDTO](mapper, User{Name: "John"})
+		if err != nil || dto.name != "" || dto.calls != 0 {
+			t.Errorf("Expected no setter calls, got %+v (err: %v)", dto, err)
+		}
+	})
+
+	t.Run("SettersCountAsMapped", func(t *testing.T) {
+		type Partial struct {
+			Name    string
+			Unknown string
+		}
+		mapper := New(WithAutoMapOptions(AutoMapOptions{UseSetters: true, FailOnUnmapped: true}))
+
+		var dto setterDTO
+		err := MapInto(mapper, Partial{Name: "John"}, &dto)
+		if !errors.Is(err, ErrUnmappedFields) || !strings.Contains(err.Error(), "Unknown") || strings.Contains(err.Error(), "Name") {
+			t.Errorf("Expected only Unknown to be unmapped, got %v", err)
+		}
+	})
+}
+
+// TestWithGlobalIgnore tests the mapper-wide ignore list for auto-maps
+func TestWithGlobalIgnore(t *testing.T) {
+	type Model struct {
+		ID        int
+		Name      string
+		Secret    string
+		CreatedAt string
+		UpdatedAt string
+	}
+	type DTO struct {
+		ID        int
+		NAME      string
+		Secret    string
+		CreatedAt string
+		UpdatedAt string
+	}
+	model := Model{ID: 1, Name: "John", Secret: "s", CreatedAt: "2024", UpdatedAt: "2025"}
+
+	t.Run("RegisterAutoMap", func(t *testing.T) {
+		mapper := New(WithGlobalIgnore("CreatedAt"), WithGlobalIgnore("UpdatedAt"))
+		RegisterAutoMap[Model, DTO](mapper)
+
+		dto, err := Map[Model, DTO](mapper, model)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := DTO{ID: 1, NAME: "John", Secret: "s"}
+		if dto != want {
+			t.Errorf("Expected %+v, got %+v", want, dto)
+		}
+
+		back, err := Map[DTO, Model](mapper, DTO{ID: 2, CreatedAt: "2024"})
+		if err != nil || back.ID != 2 || back.CreatedAt != "" {
+			t.Errorf("Expected CreatedAt ignored in reverse, got %+v (err: %v)", back, err)
+		}
+	})
+
+	t.Run("MergedWithPerRegistrationIgnores", func(t *testing.T) {
+		mapper := New(WithGlobalIgnore("CreatedAt", "UpdatedAt"))
+		RegisterAutoMapWithOptions[Model, DTO](mapper, AutoMapOptions{IgnoreFields: []string{"Secret"}, CaseInsensitive: true})
+
+		dto, err := Map[Model, DTO](mapper, model)
+		want := DTO{ID: 1, NAME: "John"}
+		if err != nil || dto != want {
+			t.Errorf("Expected %+v, got %+v (err: %v)", want, dto, err)
+		}
+	})
+
+	t.Run("MapIntoAndMapWith", func(t *testing.T) {
+		mapper := New(WithGlobalIgnore("CreatedAt", "UpdatedAt"))
+
+		dst := DTO{CreatedAt: "kept"}
+		if err := MapInto(mapper, model, &dst); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dst.CreatedAt != "kept" || dst.UpdatedAt != "" || dst.NAME != "John" {
+			t.Errorf("Expected only the timestamps skipped, got %+v", dst)
+		}
+
+		dto, err := MapWith[Model, DTO](mapper, model, WithCaseInsensitive())
+		if err != nil || dto.CreatedAt != "" || dto.ID != 1 {
+			t.Errorf("Expected CreatedAt ignored, got %+v (err: %v)", dto, err)
+		}
+	})
+
+	t.Run("BuilderAndOptionsDoNotShareSlices", func(t *testing.T) {
+		names := []string{"CreatedAt"}
+		mapper := NewBuilder().IgnoreFields("Secret").With(WithGlobalIgnore(names...)).Build()
+		names[0] = "ID"
+		RegisterAutoMap[Model, DTO](mapper)
+
+		// The builder's IgnoreFields make names match exactly, so NAME stays empty too
+		dto, err := Map[Model, DTO](mapper, model)
+		want := DTO{ID: 1, UpdatedAt: "2025"}
+		if err != nil || dto != want {
+			t.Errorf("Expected %+v, got %+v (err: %v)", want, dto, err)
+		}
+	})
+}
+
+// TestAutoMapContinueOnNestedError tests collecting nested mapping errors while still
+// mapping the sibling fields
+func TestAutoMapContinueOnNestedError(t *testing.T) {
+	type Import struct {
+		Name    string
+		Dates   []string
+		Scores  map[string]int
+		Age     int
+		Country string
+	}
+	type Record struct {
+		Name    string
+		Dates   []time.Time
+		Scores  map[int]int
+		Age     int
+		Country string
+	}
+	src := Import{
+		Name:   "John",
+		Dates:  []string{"2024-05-01T10:00:00Z", "yesterday"},
+		Scores: map[string]int{"math": 9},
+		Age:    30,
+	}
+
+	t.Run("CollectsErrorsAndMapsSiblings", func(t *testing.T) {
+		mapper := New()
+		RegisterTimeConversions(mapper, time.UTC, time.RFC3339)
+		RegisterAutoMapWithOptions[Import, Record](mapper, AutoMapOptions{
+			ContinueOnNestedError: true,
+			Defaults:              map[string]any{"Country": "VN"},
+		})
+
+		record, err := Map[Import, Record](mapper, src)
+		var nested *NestedMapError
+		if !errors.As(err, &nested) {
+			t.Fatalf("Expected a *NestedMapError, got %v", err)
+		}
+		if len(nested.Errs) != 2 {
+			t.Errorf("Expected 2 field errors, got %d: %v", len(nested.Errs), nested.Errs)
+		}
+		var parseErr *time.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Expected the error to wrap a *time.ParseError, got %v", err)
+		}
+		if !errors.Is(err, copier.ErrMapKeyNotMatch) {
+			t.Errorf("Expected the error to wrap copier.ErrMapKeyNotMatch, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "field Dates") || !strings.Contains(err.Error(), "field Scores") {
+			t.Errorf("Expected the error to name both fields, got %q", err.Error())
+		}
+
+		want := Record{Name: "John", Age: 30, Country: "VN"}
+		if !reflect.DeepEqual(record, want) {
+			t.Errorf("Expected partial result %+v, got %+v", want, record)
+		}
+
+		ptr, err := Map[*Import, *Record](mapper, &src)
+		if err == nil || ptr == nil || ptr.Name != "John" {
+			t.Errorf("Expected a partial pointer result with an error, got %+v, %v", ptr, err)
+		}
+	})
+
+	t.Run("NoErrors", func(t *testing.T) {
+		mapper := New()
+		RegisterTimeConversions(mapper, time.UTC, time.RFC3339)
+		RegisterAutoMapWithOptions[Import, Record](mapper, AutoMapOptions{
+			ContinueOnNestedError: true,
+			IgnoreFields:          []string{"Scores"},
+		})
+
+		record, err := Map[Import, Record](mapper, Import{Name: "John", Dates: []string{"2024-05-01T10:00:00Z"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if record.Name != "John" || len(record.Dates) != 1 || record.Dates[0].Year() != 2024 {
+			t.Errorf("Expected the record to be mapped, got %+v", record)
+		}
+	})
+
+	t.Run("WithoutOption", func(t *testing.T) {
+		mapper := New()
+		RegisterTimeConversions(mapper, time.UTC, time.RFC3339)
+		RegisterAutoMapWithOptions[Import, Record](mapper, AutoMapOptions{CaseInsensitive: true})
+
+		record, err := Map[Import, Record](mapper, src)
+		var nested *NestedMapError
+		if err == nil || errors.As(err, &nested) {
+			t.Errorf("Expected the first failure to abort the mapping, got %v", err)
+		}
+		if record.Name != "" {
+			t.Errorf("Expected zero value, got %+v", record)
+		}
+	})
+}
@@ -1,8 +1,12 @@
 package mapper
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/jinzhu/copier"
 )
 
 // TestAutoMap tests the automatic mapping functionality
@@ -266,6 +270,170 @@ func TestAutoMap(t *testing.T) {
 	})
 }
 
+// TestCopyFields tests the public field copy through the same cases as TestAutoMap
+func TestCopyFields(t *testing.T) {
+	t.Run("StructWithSameFieldNames", func(t *testing.T) {
+		type Source struct {
+			Name string
+			Age  int
+		}
+		type Dest struct {
+			Name string
+			Age  int
+		}
+
+		result, err := CopyFields[Source, Dest](Source{Name: "John", Age: 30})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Name != "John" || result.Age != 30 {
+			t.Errorf("Expected {John 30}, got %+v", result)
+		}
+	})
+
+	t.Run("StructWithDifferentFieldNames", func(t *testing.T) {
+		type Source struct {
+			Name string
+			Age  int
+		}
+		type Dest struct {
+			FullName string
+			Years    int
+		}
+
+		result, err := CopyFields[Source, Dest](Source{Name: "John", Age: 30})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.FullName != "" || result.Years != 0 {
+			t.Errorf("Expected zero values for non-matching fields, got %+v", result)
+		}
+	})
+
+	t.Run("NestedStructsSlicesAndMaps", func(t *testing.T) {
+		type Address struct {
+			Street string
+			City   string
+		}
+		type Source struct {
+			Address Address
+			Tags    []string
+			Attrs   map[string]string
+			Value   interface{}
+		}
+		type Dest struct {
+			Address Address
+			Tags    []string
+			Attrs   map[string]string
+			Value   interface{}
+		}
+
+		src := Source{
+			Address: Address{Street: "123 Main St", City: "NYC"},
+			Tags:    []string{"dev", "go"},
+			Attrs:   map[string]string{"role": "dev"},
+			Value:   42,
+		}
+		result, err := CopyFields[Source, Dest](src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(Dest(src), result) {
+			t.Errorf("Expected %+v, got %+v", src, result)
+		}
+	})
+
+	t.Run("PointerFieldsAreDeepCopied", func(t *testing.T) {
+		type Source struct{ Name *string }
+		type Dest struct{ Name *string }
+
+		name := "John"
+		src := Source{Name: &name}
+		result, err := CopyFields[Source, Dest](src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Name == nil || *result.Name != "John" {
+			t.Errorf("Expected Name pointer to be copied, got %v", result.Name)
+		}
+		if result.Name == src.Name {
+			t.Error("Expected deep copy, but pointers are the same")
+		}
+	})
+
+	t.Run("EmbeddedStructs", func(t *testing.T) {
+		type BaseInfo struct {
+			ID   int
+			Name string
+		}
+		type Source struct {
+			BaseInfo
+			Email string
+		}
+		type Dest struct {
+			BaseInfo
+			Email string
+		}
+
+		src := Source{BaseInfo: BaseInfo{ID: 1, Name: "John"}, Email: "john@example.com"}
+		result, err := CopyFields[Source, Dest](src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.ID != 1 || result.Name != "John" || result.Email != "john@example.com" {
+			t.Errorf("Expected embedded struct fields to be copied, got %+v", result)
+		}
+	})
+
+	t.Run("SameTypeAndPrimitives", func(t *testing.T) {
+		type Empty struct{}
+
+		if result, err := CopyFields[Empty, Empty](Empty{}); err != nil || result != (Empty{}) {
+			t.Errorf("Expected empty struct, got %+v, %v", result, err)
+		}
+		if result, err := CopyFields[string, string]("hello"); err != nil || result != "hello" {
+			t.Errorf("Expected 'hello', got %q, %v", result, err)
+		}
+		if result, err := CopyFields[string, int]("hello"); err != nil || result != 0 {
+			t.Errorf("Expected zero value for incompatible types, got %d, %v", result, err)
+		}
+	})
+
+	t.Run("PointerDestination", func(t *testing.T) {
+		type Source struct{ Name string }
+		type Dest struct{ Name string }
+
+		result, err := CopyFields[*Source, *Dest](&Source{Name: "John"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result == nil || result.Name != "John" {
+			t.Errorf("Expected &{John}, got %+v", result)
+		}
+
+		result, err = CopyFields[*Source, *Dest](nil)
+		if err != nil || result != nil {
+			t.Errorf("Expected nil for nil source, got %+v, %v", result, err)
+		}
+	})
+
+	t.Run("ReportsCopierErrors", func(t *testing.T) {
+		_, err := CopyFields[map[string]int, map[int]int](map[string]int{"a": 1})
+		if !errors.Is(err, copier.ErrMapKeyNotMatch) {
+			t.Errorf("Expected copier.ErrMapKeyNotMatch, got %v", err)
+		}
+
+		type Tagged struct {
+			Name string `copier:"name"`
+		}
+		type Dest struct{ Name string }
+		_, err = CopyFields[Tagged, Dest](Tagged{Name: "John"})
+		if !errors.Is(err, copier.ErrFieldNameTagStartNotUpperCase) {
+			t.Errorf("Expected copier.ErrFieldNameTagStartNotUpperCase, got %v", err)
+		}
+	})
+}
+
 // TestRegisterAutoMap tests the automatic mapping registration functionality
 func TestRegisterAutoMap(t *testing.T) {
 	t.Run("RegisterAutoMapSimpleStruct", func(t *testing.T) {
@@ -296,6 +464,45 @@ func TestRegisterAutoMap(t *testing.T) {
 		}
 	})
 
+	t.Run("RegisterAutoMapRejectsDifferentPrimitives", func(t *testing.T) {
+		mapper := New()
+
+		defer func() {
+			err, ok := recover().(error)
+			if !ok || !errors.Is(err, ErrAutoMapUnsupported) {
+				t.Errorf("Expected panic with ErrAutoMapUnsupported, got %v", err)
+			}
+			if Has[string, int](mapper) || Has[int, string](mapper) {
+				t.Error("Expected no mapping to be registered")
+			}
+		}()
+
+		RegisterAutoMap[string, int](mapper)
+	})
+
+	t.Run("RegisterAutoMapRejectsStructToPrimitive", func(t *testing.T) {
+		mapper := New()
+
+		defer func() {
+			err, ok := recover().(error)
+			if !ok || !errors.Is(err, ErrAutoMapUnsupported) {
+				t.Errorf("Expected panic with ErrAutoMapUnsupported, got %v", err)
+			}
+		}()
+
+		RegisterAutoMapWithOptions[Person, string](mapper, AutoMapOptions{MatchByJSONTag: true})
+	})
+
+	t.Run("RegisterAutoMapAcceptsIdenticalPrimitives", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[string, string](mapper)
+
+		result, err := Map[string, string](mapper, "hello")
+		if err != nil || result != "hello" {
+			t.Errorf("Expected hello, got %q (err: %v)", result, err)
+		}
+	})
+
 	t.Run("RegisterAutoMapPartialMatch", func(t *testing.T) {
 		type Source struct {
 			Name  string
@@ -558,4 +765,840 @@ func TestRegisterAutoMap(t *testing.T) {
 			t.Errorf("Expected Foo{42, hello}, got %+v", foo2)
 		}
 	})
+
+	t.Run("RegisterAutoMapGenericInstantiations", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[GenericStruct[int], GenericStructDTO[int]](mapper)
+
+		result, err := Map[GenericStruct[int], GenericStructDTO[int]](mapper, GenericStruct[int]{Data: 7})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Data != 7 {
+			t.Errorf("Expected {7}, got %+v", result)
+		}
+
+		back, err := Map[GenericStructDTO[int], GenericStruct[int]](mapper, result)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if back.Data != 7 {
+			t.Errorf("Expected {7}, got %+v", back)
+		}
+
+		if Has[GenericStruct[string], GenericStructDTO[string]](mapper) {
+			t.Error("Expected GenericStruct[string] automap to not be registered")
+		}
+	})
+
+	t.Run("RegisterAutoMapGenericWithStructParameter", func(t *testing.T) {
+		type PersonView struct {
+			Name string
+			Age  int
+		}
+
+		mapper := New()
+		RegisterAutoMap[GenericStruct[Person], GenericStructDTO[PersonView]](mapper)
+
+		src := GenericStruct[Person]{Data: Person{Name: "John", Age: 30}}
+		result, err := Map[GenericStruct[Person], GenericStructDTO[PersonView]](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Data.Name != "John" || result.Data.Age != 30 {
+			t.Errorf("Expected nested type parameter fields to be copied, got %+v", result.Data)
+		}
+	})
+
+	t.Run("RegisterAutoMapGenericWithMappedTypeParameter", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterAutoMap[GenericStruct[Person], GenericStruct[PersonDTO]](mapper)
+
+		src := GenericStruct[Person]{Data: Person{Name: "John", Age: 30}}
+		result, err := Map[GenericStruct[Person], GenericStruct[PersonDTO]](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Data.FullName != "John" || result.Data.Years != 30 {
+			t.Errorf("Expected Data to be mapped by personToDTO, got %+v", result.Data)
+		}
+
+		back, err := Map[GenericStruct[PersonDTO], GenericStruct[Person]](mapper, result)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if back.Data != (Person{}) {
+			t.Errorf("Expected Data without a registered mapping to be copied by name, got %+v", back.Data)
+		}
+	})
+}
+
+// TestRegisterAutoMapNestedSlices tests auto-mapping slice fields whose element types differ
+func TestRegisterAutoMapNestedSlices(t *testing.T) {
+	type Source struct {
+		Name    string
+		Members []Person
+	}
+	type Dest struct {
+		Name    string
+		Members []PersonDTO
+	}
+
+	t.Run("MapsElementsThroughRegistry", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterAutoMap[Source, Dest](mapper)
+
+		src := Source{Name: "Core", Members: []Person{{Name: "Alice", Age: 20}, {Name: "Bob", Age: 30}}}
+		got, err := Map[Source, Dest](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := Dest{Name: "Core", Members: []PersonDTO{{"Alice", 20}, {"Bob", 30}}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	})
+
+	t.Run("ElementMappingRegisteredLater", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[Source, Dest](mapper)
+		Register(mapper, personToDTO)
+
+		got, err := Map[Source, Dest](mapper, Source{Members: []Person{{Name: "Alice"}}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got.Members) != 1 || got.Members[0].FullName != "Alice" {
+			t.Errorf("Expected [{Alice 0}], got %v", got.Members)
+		}
+	})
+
+	t.Run("PointerElements", func(t *testing.T) {
+		type PtrDest struct {
+			Members []*PersonDTO
+		}
+
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterAutoMap[Source, PtrDest](mapper)
+
+		got, err := Map[Source, PtrDest](mapper, Source{Members: []Person{{Name: "Alice"}}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got.Members) != 1 || got.Members[0] == nil || got.Members[0].FullName != "Alice" {
+			t.Errorf("Expected [&{Alice 0}], got %v", got.Members)
+		}
+	})
+
+	t.Run("NilSliceStaysNil", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterAutoMap[Source, Dest](mapper)
+
+		got, err := Map[Source, Dest](mapper, Source{Name: "Empty"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Members != nil {
+			t.Errorf("Expected nil members, got %v", got.Members)
+		}
+	})
+
+	t.Run("FailingElementReturnsError", func(t *testing.T) {
+		errUnderage := errors.New("underage")
+		mapper := New()
+		RegisterE(mapper, func(p Person) (PersonDTO, error) {
+			if p.Age < 18 {
+				return PersonDTO{}, errUnderage
+			}
+			return personToDTO(p), nil
+		})
+		RegisterAutoMap[Source, Dest](mapper)
+
+		src := Source{Name: "Core", Members: []Person{{Name: "Alice", Age: 20}, {Name: "Bob", Age: 10}}}
+		_, err := Map[Source, Dest](mapper, src)
+		if !errors.Is(err, errUnderage) {
+			t.Errorf("Expected the element mapping error, got %v", err)
+		}
+	})
+
+	t.Run("WithoutElementMappingFallsBackToCopier", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[Source, Dest](mapper)
+
+		got, err := Map[Source, Dest](mapper, Source{Name: "Core", Members: []Person{{Name: "Alice"}}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Name != "Core" {
+			t.Errorf("Expected Core, got %s", got.Name)
+		}
+		if len(got.Members) == 1 && got.Members[0].FullName != "" {
+			t.Errorf("Expected unmatched element fields to stay empty, got %v", got.Members)
+		}
+	})
+}
+
+// TestRegisterAutoMapInterfaceFields tests auto-mapping interface fields by the dynamic
+// type of their value
+func TestRegisterAutoMapInterfaceFields(t *testing.T) {
+	type Envelope struct {
+		ID      int
+		Payload any
+	}
+	type EnvelopeDTO struct {
+		ID      int
+		Payload any
+	}
+
+	t.Run("MapsPointerPayload", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterAutoMap[Envelope, EnvelopeDTO](mapper)
+
+		got, err := Map[Envelope, EnvelopeDTO](mapper, Envelope{ID: 1, Payload: &Person{Name: "Alice", Age: 20}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		dto, ok := got.Payload.(*PersonDTO)
+		if !ok || dto.FullName != "Alice" || dto.Years != 20 {
+			t.Errorf("Expected *PersonDTO{Alice 20}, got %#v", got.Payload)
+		}
+		if got.ID != 1 {
+			t.Errorf("Expected ID 1, got %d", got.ID)
+		}
+	})
+
+	t.Run("MapsValuePayload", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterAutoMapWithOptions[Envelope, EnvelopeDTO](mapper, AutoMapOptions{CaseInsensitive: true})
+
+		got, err := Map[Envelope, EnvelopeDTO](mapper, Envelope{Payload: Person{Name: "Bob"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto, ok := got.Payload.(PersonDTO); !ok || dto.FullName != "Bob" {
+			t.Errorf("Expected PersonDTO{Bob 0}, got %#v", got.Payload)
+		}
+	})
+
+	t.Run("UnmappableValuesCopiedAsIs", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		Register(mapper, func(p Person) string { return p.Name })
+		RegisterAutoMap[Envelope, EnvelopeDTO](mapper)
+
+		for _, payload := range []any{nil, 42, Person{Name: "Ambiguous"}} {
+			got, err := Map[Envelope, EnvelopeDTO](mapper, Envelope{Payload: payload})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got.Payload, payload) {
+				t.Errorf("Expected %#v, got %#v", payload, got.Payload)
+			}
+		}
+	})
+
+	t.Run("RegistryChangesInvalidateMatch", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterAutoMap[Envelope, EnvelopeDTO](mapper)
+
+		src := Envelope{Payload: Person{Name: "Dana"}}
+		if got, _ := Map[Envelope, EnvelopeDTO](mapper, src); !reflect.DeepEqual(got.Payload, PersonDTO{FullName: "Dana"}) {
+			t.Errorf("Expected PersonDTO{Dana 0}, got %#v", got.Payload)
+		}
+
+		// A second candidate makes the match ambiguous, so the value is copied as-is
+		Register(mapper, func(p Person) string { return p.Name })
+		if got, _ := Map[Envelope, EnvelopeDTO](mapper, src); !reflect.DeepEqual(got.Payload, src.Payload) {
+			t.Errorf("Expected the payload copied as-is, got %#v", got.Payload)
+		}
+
+		Remove[Person, string](mapper)
+		if got, _ := Map[Envelope, EnvelopeDTO](mapper, src); !reflect.DeepEqual(got.Payload, PersonDTO{FullName: "Dana"}) {
+			t.Errorf("Expected PersonDTO{Dana 0} again, got %#v", got.Payload)
+		}
+	})
+
+	t.Run("InterfaceConstrainsDestination", func(t *testing.T) {
+		type Holder struct{ Item labeler }
+		type HolderDTO struct{ Item labeler }
+
+		mapper := New()
+		Register(mapper, func(l label) string { return string(l) })
+		Register(mapper, func(l label) labelDTO { return labelDTO("dto:" + l) })
+		RegisterAutoMap[Holder, HolderDTO](mapper)
+
+		got, err := Map[Holder, HolderDTO](mapper, Holder{Item: label("Carol")})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Item == nil || got.Item.Label() != "dto:Carol" {
+			t.Errorf("Expected dto:Carol, got %#v", got.Item)
+		}
+	})
+}
+
+// labeler is implemented by the label types used in interface field tests
+type labeler interface{ Label() string }
+
+// label and labelDTO are a source and destination type implementing labeler
+type (
+	label    string
+	labelDTO string
+)
+
+// Label returns the label text
+func (l label) Label() string { return string(l) }
+
+// Label returns the label text
+func (l labelDTO) Label() string { return string(l) }
+
+// author and book refer to each other, as do their DTOs, for tests of mutually recursive
+// auto-mappings
+type (
+	author struct {
+		Name  string
+		Books []*book
+	}
+	book struct {
+		Title  string
+		Author *author
+	}
+	authorDTO struct {
+		Name  string
+		Books []*bookDTO
+	}
+	bookDTO struct {
+		Title  string
+		Author *authorDTO
+	}
+)
+
+// TestRegisterAutoMapRecursiveTypes tests auto-mapping self-referential struct types
+func TestRegisterAutoMapRecursiveTypes(t *testing.T) {
+	type Node struct {
+		Name     string
+		Parent   *Node
+		Children []*Node
+	}
+	type NodeDTO struct {
+		Name     string
+		Parent   *NodeDTO
+		Children []*NodeDTO
+	}
+
+	newTree := func() *Node {
+		root := &Node{Name: "root"}
+		child := &Node{Name: "child", Parent: root}
+		root.Children = []*Node{child}
+		return root
+	}
+
+	t.Run("BackPointerToAncestor", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[*Node, *NodeDTO](mapper)
+
+		got, err := Map[*Node, *NodeDTO](mapper, newTree())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Name != "root" || len(got.Children) != 1 {
+			t.Fatalf("Expected root with one child, got %+v", got)
+		}
+		child := got.Children[0]
+		if child.Name != "child" {
+			t.Errorf("Expected child, got %s", child.Name)
+		}
+		if child.Parent != got {
+			t.Errorf("Expected child's parent to be the mapped root, got %p want %p", child.Parent, got)
+		}
+	})
+
+	t.Run("SharedNodesStayShared", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[*Node, *NodeDTO](mapper)
+
+		shared := &Node{Name: "shared"}
+		root := &Node{Name: "root", Children: []*Node{shared, shared}}
+
+		got, err := Map[*Node, *NodeDTO](mapper, root)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got.Children) != 2 || got.Children[0] != got.Children[1] {
+			t.Errorf("Expected both children to be the same mapped node, got %v", got.Children)
+		}
+	})
+
+	t.Run("ValueRegistration", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[Node, NodeDTO](mapper)
+
+		got, err := Map[Node, NodeDTO](mapper, *newTree())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got.Children) != 1 || got.Children[0].Parent == nil || got.Children[0].Parent.Name != "root" {
+			t.Fatalf("Expected child pointing back to a root copy, got %+v", got.Children)
+		}
+		parent := got.Children[0].Parent
+		if len(parent.Children) != 1 || parent.Children[0] != got.Children[0] {
+			t.Errorf("Expected the root copy to share the mapped child, got %+v", parent.Children)
+		}
+		got.Name = "renamed"
+		if parent.Name != "root" {
+			t.Errorf("Expected the root copy not to share the returned root, got %s", parent.Name)
+		}
+	})
+
+	t.Run("MutuallyRecursiveTypes", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[*author, *authorDTO](mapper)
+		RegisterAutoMap[*book, *bookDTO](mapper)
+
+		a := &author{Name: "Ann"}
+		b := &book{Title: "Go", Author: a}
+		a.Books = []*book{b, b}
+
+		got, err := Map[*author, *authorDTO](mapper, a)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got.Books) != 2 || got.Books[0].Title != "Go" {
+			t.Fatalf("Expected two mapped books, got %+v", got.Books)
+		}
+		if got.Books[0] != got.Books[1] {
+			t.Errorf("Expected the shared book to be mapped once, got %p and %p", got.Books[0], got.Books[1])
+		}
+		if got.Books[0].Author != got {
+			t.Errorf("Expected the book's author to be the mapped root, got %p want %p", got.Books[0].Author, got)
+		}
+
+		gotBook, err := Map[*book, *bookDTO](mapper, b)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if gotBook.Author == nil || len(gotBook.Author.Books) != 2 || gotBook.Author.Books[0] != gotBook {
+			t.Errorf("Expected the author's books to point back to the mapped root, got %+v", gotBook.Author)
+		}
+	})
+
+	t.Run("ReverseDirection", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[*Node, *NodeDTO](mapper)
+
+		dto := &NodeDTO{Name: "root"}
+		dto.Children = []*NodeDTO{{Name: "child", Parent: dto}}
+
+		got, err := Map[*NodeDTO, *Node](mapper, dto)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got.Children) != 1 || got.Children[0].Parent != got {
+			t.Errorf("Expected child's parent to be the mapped root, got %+v", got.Children)
+		}
+	})
+
+	t.Run("NilRoot", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[*Node, *NodeDTO](mapper)
+
+		got, err := Map[*Node, *NodeDTO](mapper, nil)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil for nil source, got %+v (err: %v)", got, err)
+		}
+	})
+}
+
+// TestAnonymousStructs tests registering and auto-mapping anonymous struct types
+func TestAnonymousStructs(t *testing.T) {
+	type Named struct{ Name string }
+
+	t.Run("RegisterAndMap", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(p Person) struct{ Name string } {
+			return struct{ Name string }{Name: p.Name}
+		})
+
+		got, err := Map[Person, struct{ Name string }](mapper, Person{Name: "Alice"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Name != "Alice" {
+			t.Errorf("Expected Alice, got %s", got.Name)
+		}
+	})
+
+	t.Run("IdenticalLiteralsAreOneType", func(t *testing.T) {
+		// Declared separately, but structurally identical unnamed types are the same type
+		a := reflect.TypeOf(struct{ Name string }{})
+		b := reflect.TypeOf(func() any { return struct{ Name string }{} }())
+		if a != b {
+			t.Fatalf("Expected identical anonymous structs to share a type")
+		}
+
+		mapper := New()
+		Register(mapper, func(s struct{ Name string }) string { return s.Name })
+		if !Has[struct{ Name string }, string](mapper) {
+			t.Error("Expected the mapping to be found through an identical literal")
+		}
+	})
+
+	t.Run("TagsMakeDistinctTypes", func(t *testing.T) {
+		type Tagged = struct {
+			Name string `json:"name"`
+		}
+
+		mapper := New()
+		Register(mapper, func(s struct{ Name string }) string { return s.Name })
+		if Has[Tagged, string](mapper) {
+			t.Error("Expected a tagged anonymous struct to be a different type")
+		}
+	})
+
+	t.Run("AutoMapSameTypeFastPath", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[struct{ Name string }, struct{ Name string }](mapper)
+
+		got, err := Map[struct{ Name string }, struct{ Name string }](mapper, struct{ Name string }{Name: "Alice"})
+		if err != nil || got.Name != "Alice" {
+			t.Errorf("Expected Alice, got %+v (err: %v)", got, err)
+		}
+	})
+
+	t.Run("AutoMapBetweenDistinctAnonymousTypes", func(t *testing.T) {
+		type Tagged = struct {
+			Name string `json:"name"`
+			Age  int    `json:"age"`
+		}
+
+		mapper := New()
+		RegisterAutoMap[struct{ Name string }, Tagged](mapper)
+
+		got, err := Map[struct{ Name string }, Tagged](mapper, struct{ Name string }{Name: "Alice"})
+		if err != nil || got.Name != "Alice" || got.Age != 0 {
+			t.Errorf("Expected {Alice 0}, got %+v (err: %v)", got, err)
+		}
+	})
+
+	t.Run("AnonymousAndNamed", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[struct{ Name string }, Named](mapper)
+
+		got, err := Map[Named, struct{ Name string }](mapper, Named{Name: "Alice"})
+		if err != nil || got.Name != "Alice" {
+			t.Errorf("Expected Alice, got %+v (err: %v)", got, err)
+		}
+	})
+}
+
+// panickyOrder has a method copier calls to fill a field of the same name, which panics
+// when Items is empty
+type panickyOrder struct {
+	ID    int
+	Items []int
+}
+
+func (o panickyOrder) First() int { return o.Items[0] }
+
+type panickyOrderDTO struct {
+	ID    int
+	First int
+}
+
+// TestAutoMapCopierPanic tests that copier panics are reported as errors
+func TestAutoMapCopierPanic(t *testing.T) {
+	t.Run("CopyFields", func(t *testing.T) {
+		dto, err := CopyFields[panickyOrder, panickyOrderDTO](panickyOrder{ID: 1})
+		if !errors.Is(err, ErrCopierPanic) {
+			t.Fatalf("Expected ErrCopierPanic, got %v", err)
+		}
+		if dto != (panickyOrderDTO{}) {
+			t.Errorf("Expected zero value, got %+v", dto)
+		}
+		if !strings.Contains(err.Error(), "mapper.panickyOrder to mapper.panickyOrderDTO") {
+			t.Errorf("Expected error to name the type pair, got %q", err.Error())
+		}
+
+		dto, err = CopyFields[panickyOrder, panickyOrderDTO](panickyOrder{ID: 1, Items: []int{5}})
+		if err != nil || dto != (panickyOrderDTO{ID: 1, First: 5}) {
+			t.Errorf("Expected {1 5}, got %+v, %v", dto, err)
+		}
+	})
+
+	t.Run("RegisteredAutoMap", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[panickyOrder, panickyOrderDTO](mapper)
+
+		dto, err := Map[panickyOrder, panickyOrderDTO](mapper, panickyOrder{ID: 1})
+		if !errors.Is(err, ErrCopierPanic) {
+			t.Errorf("Expected ErrCopierPanic, got %v", err)
+		}
+		if dto != (panickyOrderDTO{}) {
+			t.Errorf("Expected zero value, got %+v", dto)
+		}
+
+		_, err = MapSlice[[]panickyOrder, []panickyOrderDTO](mapper, []panickyOrder{{ID: 1, Items: []int{1}}, {ID: 2}})
+		if !errors.Is(err, ErrCopierPanic) {
+			t.Errorf("Expected ErrCopierPanic from MapSlice, got %v", err)
+		}
+	})
+
+	t.Run("MapInto", func(t *testing.T) {
+		dto := panickyOrderDTO{ID: 9}
+		if err := MapInto(New(), panickyOrder{ID: 1}, &dto); !errors.Is(err, ErrCopierPanic) {
+			t.Errorf("Expected ErrCopierPanic, got %v", err)
+		}
+	})
+}
+
+// TestAutoMapCopierErrors tests that copier errors are returned by Map
+func TestAutoMapCopierErrors(t *testing.T) {
+	type Source struct {
+		Name   string
+		Scores map[string]int
+	}
+	type Dest struct {
+		Name   string
+		Scores map[int]int
+	}
+	src := Source{Name: "John", Scores: map[string]int{"math": 9}}
+
+	t.Run("DefaultOptions", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[Source, Dest](mapper)
+
+		dto, err := Map[Source, Dest](mapper, src)
+		if !errors.Is(err, copier.ErrMapKeyNotMatch) {
+			t.Fatalf("Expected copier.ErrMapKeyNotMatch, got %v", err)
+		}
+		if !strings.HasPrefix(err.Error(), "auto-map mapper.Source->mapper.Dest: ") {
+			t.Errorf("Expected error to name the auto-mapped pair, got %q", err.Error())
+		}
+		if dto.Name != "" {
+			t.Errorf("Expected zero value, got %+v", dto)
+		}
+	})
+
+	t.Run("FieldPlan", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMapWithOptions[Source, Dest](mapper, AutoMapOptions{CaseInsensitive: true})
+
+		_, err := Map[Source, Dest](mapper, src)
+		if !errors.Is(err, copier.ErrMapKeyNotMatch) {
+			t.Fatalf("Expected copier.ErrMapKeyNotMatch, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "field Scores") {
+			t.Errorf("Expected error to name the field, got %q", err.Error())
+		}
+	})
+}
+
+// TestAutoMapEmbeddedPointers tests auto-mapping structs that embed a struct by pointer
+func TestAutoMapEmbeddedPointers(t *testing.T) {
+	type BaseInfo struct {
+		ID        int
+		CreatedBy string
+	}
+	type Model struct {
+		*BaseInfo
+		Name string
+	}
+	type DTO struct {
+		BaseInfo
+		Name string
+	}
+	type PtrDTO struct {
+		*BaseInfo
+		Name  string
+		Extra int
+	}
+
+	modes := map[string]AutoMapOptions{
+		"Default":         {},
+		"CaseInsensitive": {CaseInsensitive: true},
+	}
+	for mode, opts := range modes {
+		t.Run(mode, func(t *testing.T) {
+			mapper := New()
+			RegisterAutoMapWithOptions[Model, DTO](mapper, opts)
+			RegisterAutoMapWithOptions[Model, PtrDTO](mapper, opts)
+
+			t.Run("NilPointerToValue", func(t *testing.T) {
+				dto, err := Map[Model, DTO](mapper, Model{Name: "a"})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if dto.BaseInfo != (BaseInfo{}) || dto.Name != "a" {
+					t.Errorf("Expected zero BaseInfo and name a, got %+v", dto)
+				}
+			})
+
+			t.Run("PointerToValue", func(t *testing.T) {
+				dto, err := Map[Model, DTO](mapper, Model{BaseInfo: &BaseInfo{ID: 1, CreatedBy: "ann"}, Name: "a"})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if dto.BaseInfo != (BaseInfo{ID: 1, CreatedBy: "ann"}) || dto.Name != "a" {
+					t.Errorf("Expected {1 ann} and name a, got %+v", dto)
+				}
+			})
+
+			t.Run("ValueToPointer", func(t *testing.T) {
+				model, err := Map[DTO, Model](mapper, DTO{BaseInfo: BaseInfo{ID: 2, CreatedBy: "bob"}})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if model.BaseInfo == nil || *model.BaseInfo != (BaseInfo{ID: 2, CreatedBy: "bob"}) {
+					t.Errorf("Expected &{2 bob}, got %+v", model.BaseInfo)
+				}
+			})
+
+			t.Run("ZeroValueToPointer", func(t *testing.T) {
+				model, err := Map[DTO, Model](mapper, DTO{Name: "a"})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if model.BaseInfo == nil || *model.BaseInfo != (BaseInfo{}) {
+					t.Errorf("Expected pointer to zero BaseInfo, got %+v", model.BaseInfo)
+				}
+			})
+
+			t.Run("NilPointerToPointer", func(t *testing.T) {
+				dto, err := Map[Model, PtrDTO](mapper, Model{Name: "a"})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if dto.BaseInfo != nil || dto.Name != "a" {
+					t.Errorf("Expected nil BaseInfo and name a, got %+v", dto)
+				}
+			})
+
+			t.Run("PointerToPointer", func(t *testing.T) {
+				base := &BaseInfo{ID: 3}
+				dto, err := Map[Model, PtrDTO](mapper, Model{BaseInfo: base})
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if dto.BaseInfo == nil || *dto.BaseInfo != *base {
+					t.Errorf("Expected &{3 }, got %+v", dto.BaseInfo)
+				}
+				if dto.BaseInfo == base {
+					t.Error("Expected embedded pointer not to alias the source")
+				}
+			})
+		})
+	}
+
+	t.Run("MapIntoNilPointerLeavesDestination", func(t *testing.T) {
+		mapper := New()
+		dst := DTO{BaseInfo: BaseInfo{ID: 9}}
+		if err := MapInto(mapper, Model{Name: "a"}, &dst); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dst.ID != 9 || dst.Name != "a" {
+			t.Errorf("Expected ID 9 and name a, got %+v", dst)
+		}
+	})
+}
+
+// TestRegisterAutoMapValues tests registering auto-mappings from sample values
+func TestRegisterAutoMapValues(t *testing.T) {
+	type Member struct{ Name string }
+	type MemberDTO struct{ Name string }
+	type Team struct {
+		ID      int
+		Title   string
+		Members []Member
+	}
+	type TeamDTO struct {
+		ID      int
+		TITLE   string
+		Members []MemberDTO
+		Extra   string
+	}
+
+	t.Run("BothDirections", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[Member, MemberDTO](mapper)
+		var src, dst any = Team{}, TeamDTO{}
+		if err := RegisterAutoMapValues(mapper, src, dst); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		dto, err := Map[Team, TeamDTO](mapper, Team{ID: 1, Title: "core", Members: []Member{{Name: "Ann"}}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := TeamDTO{ID: 1, TITLE: "core", Members: []MemberDTO{{Name: "Ann"}}}
+		if !reflect.DeepEqual(dto, want) {
+			t.Errorf("Expected %+v, got %+v", want, dto)
+		}
+
+		team, err := Map[*TeamDTO, *Team](mapper, &want)
+		if err != nil || team == nil || team.ID != 1 || team.Title != "core" || len(team.Members) != 1 {
+			t.Errorf("Expected the reverse mapping, got %+v (err: %v)", team, err)
+		}
+		if !Has[TeamDTO, Team](mapper) {
+			t.Error("Expected TeamDTO->Team to be registered")
+		}
+	})
+
+	t.Run("PointerSample", func(t *testing.T) {
+		mapper := New()
+		if err := RegisterAutoMapValues(mapper, &Member{}, MemberDTO{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		fnType, _ := Signature[Member, MemberDTO](mapper)
+		if fnType != reflect.TypeOf(func(*Member) MemberDTO { return MemberDTO{} }) {
+			t.Errorf("Expected func(*Member) MemberDTO, got %v", fnType)
+		}
+
+		dto, err := Map[Member, MemberDTO](mapper, Member{Name: "Ann"})
+		if err != nil || dto.Name != "Ann" {
+			t.Errorf("Expected {Ann}, got %+v (err: %v)", dto, err)
+		}
+		nilDTO, err := Map[*Member, *MemberDTO](mapper, nil)
+		if err != nil || nilDTO != nil {
+			t.Errorf("Expected nil for a nil source, got %+v (err: %v)", nilDTO, err)
+		}
+		dtos, err := MapSlice[[]Member, []MemberDTO](mapper, []Member{{Name: "Bo"}})
+		if err != nil || !reflect.DeepEqual(dtos, []MemberDTO{{Name: "Bo"}}) {
+			t.Errorf("Expected [{Bo}], got %+v (err: %v)", dtos, err)
+		}
+	})
+
+	t.Run("UsesMapperOptions", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{
+			IgnoreFields: []string{"Title"},
+			Defaults:     map[string]any{"Extra": "none"},
+		}))
+		if err := RegisterAutoMapValues(mapper, Team{}, TeamDTO{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		dto, err := Map[Team, TeamDTO](mapper, Team{ID: 2, Title: "core"})
+		if err != nil || dto.ID != 2 || dto.TITLE != "" || dto.Extra != "none" {
+			t.Errorf("Expected {ID:2 Extra:none}, got %+v (err: %v)", dto, err)
+		}
+	})
+
+	t.Run("RejectsNonStructs", func(t *testing.T) {
+		mapper := New()
+		for _, samples := range [][2]any{{Team{}, "dto"}, {1, TeamDTO{}}, {nil, TeamDTO{}}, {new(*Team), TeamDTO{}}} {
+			if err := RegisterAutoMapValues(mapper, samples[0], samples[1]); !errors.Is(err, ErrAutoMapUnsupported) {
+				t.Errorf("Expected ErrAutoMapUnsupported for %T->%T, got %v", samples[0], samples[1], err)
+			}
+		}
+		if len(List(mapper)) != 0 {
+			t.Errorf("Expected nothing registered, got %v", List(mapper))
+		}
+	})
 }
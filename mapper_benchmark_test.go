@@ -2,6 +2,8 @@ package mapper
 
 import (
 	"fmt"
+	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -61,6 +63,159 @@ func BenchmarkStructMapping(b *testing.B) {
 	}
 }
 
+// largeRecord is a struct of several hundred bytes, used to measure copying costs
+type largeRecord struct {
+	ID      int64
+	Name    string
+	Payload [64]int64
+}
+
+// largeRecordDTO is the destination counterpart of largeRecord
+type largeRecordDTO struct {
+	ID      int64
+	Name    string
+	Payload [64]int64
+}
+
+// BenchmarkLargeStructMapping compares value and pointer registrations for a struct of
+// about 550 bytes, mapped through Map with value and pointer type arguments
+func BenchmarkLargeStructMapping(b *testing.B) {
+	record := &largeRecord{ID: 1, Name: "large"}
+	byValue := func(r largeRecord) largeRecordDTO {
+		return largeRecordDTO{ID: r.ID, Name: r.Name, Payload: r.Payload}
+	}
+	byPointer := func(r *largeRecord) *largeRecordDTO {
+		return &largeRecordDTO{ID: r.ID, Name: r.Name, Payload: r.Payload}
+	}
+
+	b.Run("ValueFuncValueArgs", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, byValue)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = Map[largeRecord, largeRecordDTO](mapper, *record)
+		}
+	})
+
+	b.Run("ValueFuncPointerArgs", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, byValue)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = Map[*largeRecord, *largeRecordDTO](mapper, record)
+		}
+	})
+
+	b.Run("PointerFuncPointerArgs", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, byPointer)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = Map[*largeRecord, *largeRecordDTO](mapper, record)
+		}
+	})
+
+	b.Run("PointerFuncValueArgs", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, byPointer)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = Map[largeRecord, largeRecordDTO](mapper, *record)
+		}
+	})
+}
+
+// personConverter provides a mapping method used to benchmark bound method values
+type personConverter struct {
+	prefix string
+}
+
+// Convert maps a Person to a PersonDTO, prefixing the name
+func (c personConverter) Convert(p Person) PersonDTO {
+	return PersonDTO{FullName: c.prefix + p.Name, Years: p.Age}
+}
+
+// BenchmarkStructMappingHandle measures the same mapping as BenchmarkStructMapping through
+// a MapHandle resolved once
+func BenchmarkStructMappingHandle(b *testing.B) {
+	mapper := New()
+	Register(mapper, personToDTO)
+	person := Person{Name: "Benchmark", Age: 25}
+	toDTO, _ := Handle[Person, PersonDTO](mapper)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = toDTO.Call(person)
+	}
+}
+
+// BenchmarkStructMappingPlainFunc measures mapping through a plain function with the same
+// body as personConverter.Convert, as a baseline for BenchmarkStructMappingBoundMethod
+func BenchmarkStructMappingPlainFunc(b *testing.B) {
+	mapper := New()
+	prefix := "Mr. "
+	Register(mapper, func(p Person) PersonDTO {
+		return PersonDTO{FullName: prefix + p.Name, Years: p.Age}
+	})
+	person := Person{Name: "Benchmark", Age: 25}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Map[Person, PersonDTO](mapper, person)
+	}
+}
+
+// BenchmarkStructMappingBoundMethod measures mapping through a bound method value
+func BenchmarkStructMappingBoundMethod(b *testing.B) {
+	mapper := New()
+	conv := personConverter{prefix: "Mr. "}
+	Register(mapper, conv.Convert)
+	person := Person{Name: "Benchmark", Age: 25}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Map[Person, PersonDTO](mapper, person)
+	}
+}
+
+// BenchmarkMapToPointerValueFunc measures Person -> *PersonDTO through a function returning
+// a value, which the reflection path has to copy into a newly allocated pointer
+func BenchmarkMapToPointerValueFunc(b *testing.B) {
+	mapper := New()
+	Register(mapper, personToDTO)
+	person := Person{Name: "Benchmark", Age: 25}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Map[Person, *PersonDTO](mapper, person)
+	}
+}
+
+// BenchmarkMapToPointerPointerFunc measures Person -> *PersonDTO through a function that
+// already returns a pointer, which the reflection path hands back without re-wrapping
+func BenchmarkMapToPointerPointerFunc(b *testing.B) {
+	mapper := New()
+	Register(mapper, func(p Person) *PersonDTO {
+		return &PersonDTO{FullName: p.Name, Years: p.Age}
+	})
+	person := Person{Name: "Benchmark", Age: 25}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Map[Person, *PersonDTO](mapper, person)
+	}
+}
+
 // BenchmarkSingleElementSliceMapping measures the performance of mapping a slice with one element
 func BenchmarkSingleElementSliceMapping(b *testing.B) {
 	mapper := New()
@@ -95,6 +250,162 @@ func BenchmarkLargeSliceMapping(b *testing.B) {
 	}
 }
 
+// BenchmarkLargeSlicePointerWrapping measures mapping 100 elements when every element
+// needs a pointer wrapper, for the argument or for the result
+func BenchmarkLargeSlicePointerWrapping(b *testing.B) {
+	persons := make([]Person, 100)
+	for i := 0; i < len(persons); i++ {
+		persons[i] = Person{Name: fmt.Sprintf("Person%d", i), Age: 20 + i}
+	}
+
+	b.Run("ValueToPointerResult", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = MapSlice[[]Person, []*PersonDTO](mapper, persons)
+		}
+	})
+
+	b.Run("ValueToPointerArgument", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, func(p *Person) PersonDTO { return PersonDTO{FullName: p.Name, Years: p.Age} })
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = MapSlice[[]Person, []PersonDTO](mapper, persons)
+		}
+	})
+}
+
+// BenchmarkLargeSliceIdentity compares mapping 1000 ints with RegisterIdentity, which is
+// copied at once, against an equivalent function called per element
+func BenchmarkLargeSliceIdentity(b *testing.B) {
+	ints := make([]int, 1000)
+	for i := range ints {
+		ints[i] = i
+	}
+
+	b.Run("Identity", func(b *testing.B) {
+		mapper := New()
+		RegisterIdentity[int](mapper)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = MapSlice[[]int, []int](mapper, ints)
+		}
+	})
+
+	b.Run("PerElement", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, func(v int) int { return v })
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = MapSlice[[]int, []int](mapper, ints)
+		}
+	})
+}
+
+// BenchmarkLargeSliceReduce compares summing 1000 mapped values with MapSliceReduce
+// against MapSlice followed by a loop over the mapped slice
+func BenchmarkLargeSliceReduce(b *testing.B) {
+	persons := make([]Person, 1000)
+	for i := range persons {
+		persons[i] = Person{Name: fmt.Sprintf("Person%d", i), Age: 20 + i%50}
+	}
+	mapper := New()
+	Register(mapper, func(p Person) int { return p.Age })
+
+	b.Run("Reduce", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = MapSliceReduce(mapper, persons, 0, func(sum, age int) int { return sum + age })
+		}
+	})
+
+	b.Run("MapSliceThenLoop", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ages, _ := MapSlice[[]Person, []int](mapper, persons)
+			sum := 0
+			for _, age := range ages {
+				sum += age
+			}
+			_ = sum
+		}
+	})
+}
+
+// BenchmarkLargeSliceMapElems measures MapElems on the same 100 elements as
+// BenchmarkLargeSliceMapping
+func BenchmarkLargeSliceMapElems(b *testing.B) {
+	mapper := New()
+	Register(mapper, personToDTO)
+
+	persons := make([]Person, 100)
+	for i := 0; i < len(persons); i++ {
+		persons[i] = Person{Name: fmt.Sprintf("Person%d", i), Age: 20 + i}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = MapElems[Person, PersonDTO](mapper, persons)
+	}
+}
+
+// BenchmarkParallelMapping measures concurrent Map throughput against the lock-free
+// copy-on-write registry
+func BenchmarkParallelMapping(b *testing.B) {
+	mapper := New()
+	Register(mapper, personToDTO)
+	person := Person{Name: "Benchmark", Age: 25}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = Map[Person, PersonDTO](mapper, person)
+		}
+	})
+}
+
+// BenchmarkParallelRegistryLookup measures concurrent lookups in the copy-on-write
+// registry, as done by every Map call
+func BenchmarkParallelRegistryLookup(b *testing.B) {
+	mapper := New()
+	Register(mapper, personToDTO)
+	key := keyOf[Person, PersonDTO]()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = mapper.registry.get(key)
+		}
+	})
+}
+
+// BenchmarkParallelRWMutexLookup measures the same lookups in a map guarded by a
+// sync.RWMutex, the alternative to the copy-on-write registry
+func BenchmarkParallelRWMutexLookup(b *testing.B) {
+	var mu sync.RWMutex
+	registry := map[typePair]any{keyOf[Person, PersonDTO](): personToDTO}
+	key := keyOf[Person, PersonDTO]()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.RLock()
+			_ = registry[key]
+			mu.RUnlock()
+		}
+	})
+}
+
 // BenchmarkMappingNotFound measures the performance when no mapping function is registered
 func BenchmarkMappingNotFound(b *testing.B) {
 	mapper := New()
@@ -105,6 +416,15 @@ func BenchmarkMappingNotFound(b *testing.B) {
 	}
 }
 
+// BenchmarkTypeOf measures computing the reflect.Type of a generic type parameter,
+// which Register, Has, Remove and Map do on every call
+func BenchmarkTypeOf(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = typeOf[Person]()
+	}
+}
+
 func BenchmarkHasMapping(b *testing.B) {
 	mapper := New()
 	Register(mapper, stringToInt)
@@ -145,18 +465,38 @@ func BenchmarkList(b *testing.B) {
 	}
 }
 
+// BenchmarkListLarge measures List on a registry of 5000 distinct pairs
 func BenchmarkListLarge(b *testing.B) {
-	mapper := New()
+	mapper := largeRegistry(5000)
 
-	// Register many mappings
-	for i := 0; i < 1000; i++ {
-		Register(mapper, func(i int) string { return fmt.Sprintf("%d", i) })
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = List(mapper)
 	}
+}
 
+// BenchmarkListIntoLarge measures ListInto reusing one buffer on the registry of
+// BenchmarkListLarge
+func BenchmarkListIntoLarge(b *testing.B) {
+	mapper := largeRegistry(5000)
+	var buf []string
+
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_ = List(mapper)
+		buf = ListInto(mapper, buf[:0])
+	}
+}
+
+// largeRegistry returns a mapper with n distinct pairs, from [i]int arrays to string
+func largeRegistry(n int) Mapper {
+	mapper := New()
+	intType, stringType := reflect.TypeOf(0), reflect.TypeOf("")
+	for i := 0; i < n; i++ {
+		mapper.registry.set(typePair{src: reflect.ArrayOf(i, intType), dst: stringType}, intToString)
 	}
+	return mapper
 }
 
 // Complex scenario benchmarks
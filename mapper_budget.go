@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"reflect"
+	"time"
+)
+
+// autoMapBudget holds the time limit for a single auto-mapped call and the callback
+// invoked when a call exceeds it.
+type autoMapBudget struct {
+	limit  time.Duration
+	onSlow func(src, dst reflect.Type, elapsed time.Duration)
+}
+
+// WithAutoMapBudget reports auto-mapped calls that take longer than budget. Because
+// auto-mapping is around 50x slower than a registered function, this helps catch
+// accidental use of RegisterAutoMap on hot paths.
+//
+// Only auto-mapping functions registered after the mapper is created with this option are
+// timed; the timing wrapper is added at registration, so manually registered functions
+// carry no overhead. onSlow is called synchronously after the slow call returns, with the
+// source and destination types of the pair and the measured duration. It may log,
+// increment a counter, or panic to reject the call outright.
+//
+// Parameters:
+//   - budget: The maximum duration of a single auto-mapped call
+//   - onSlow: The callback invoked for every call exceeding budget
+//
+// Example:
+//
+//	mapper := New(WithAutoMapBudget(50*time.Microsecond, func(src, dst reflect.Type, elapsed time.Duration) {
+//	    log.Printf("slow auto-map %s->%s took %s", src, dst, elapsed)
+//	}))
+//	RegisterAutoMap[User, UserDTO](mapper)
+func WithAutoMapBudget(budget time.Duration, onSlow func(src, dst reflect.Type, elapsed time.Duration)) Option {
+	return func(c *config) {
+		if onSlow == nil {
+			c.budget = nil
+			return
+		}
+		c.budget = &autoMapBudget{limit: budget, onSlow: onSlow}
+	}
+}
+
+// withAutoMapBudget wraps an auto-mapping function so that calls exceeding the mapper's
+// budget are reported. It returns fn unchanged when no budget is configured.
+func withAutoMapBudget[S any, D any](m Mapper, fn func(S) D) func(S) D {
+	budget := m.config.budget
+	if budget == nil {
+		return fn
+	}
+
+	srcType, dstType := typeOf[S](), typeOf[D]()
+	return func(src S) D {
+		start := time.Now()
+		dst := fn(src)
+		if elapsed := time.Since(start); elapsed > budget.limit {
+			budget.onSlow(srcType, dstType, elapsed)
+		}
+		return dst
+	}
+}
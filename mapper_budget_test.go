@@ -0,0 +1,92 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestWithAutoMapBudget tests reporting auto-mapped calls that exceed a time budget
+func TestWithAutoMapBudget(t *testing.T) {
+	type Item struct {
+		Name  string
+		Value int
+	}
+	type Slow struct {
+		Items []Item
+		Tags  map[string]string
+	}
+	type SlowDTO struct {
+		Items []Item
+		Tags  map[string]string
+	}
+
+	slowSource := Slow{Items: make([]Item, 1000), Tags: map[string]string{"a": "b"}}
+
+	type report struct {
+		src, dst reflect.Type
+		elapsed  time.Duration
+	}
+
+	t.Run("ReportsCallsOverBudget", func(t *testing.T) {
+		var reports []report
+		mapper := New(WithAutoMapBudget(time.Nanosecond, func(src, dst reflect.Type, elapsed time.Duration) {
+			reports = append(reports, report{src, dst, elapsed})
+		}))
+		RegisterAutoMap[Slow, SlowDTO](mapper)
+
+		dto, err := Map[Slow, SlowDTO](mapper, slowSource)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(dto.Items) != 1000 {
+			t.Errorf("Expected mapping result to be returned, got %d items", len(dto.Items))
+		}
+
+		if len(reports) != 1 {
+			t.Fatalf("Expected 1 report, got %d", len(reports))
+		}
+		if reports[0].src != typeOf[Slow]() || reports[0].dst != typeOf[SlowDTO]() {
+			t.Errorf("Expected Slow->SlowDTO, got %s->%s", reports[0].src, reports[0].dst)
+		}
+		if reports[0].elapsed <= time.Nanosecond {
+			t.Errorf("Expected elapsed time over budget, got %s", reports[0].elapsed)
+		}
+	})
+
+	t.Run("IgnoresCallsWithinBudget", func(t *testing.T) {
+		calls := 0
+		mapper := New(WithAutoMapBudget(time.Hour, func(reflect.Type, reflect.Type, time.Duration) {
+			calls++
+		}))
+		RegisterAutoMap[Slow, SlowDTO](mapper)
+
+		_, _ = Map[Slow, SlowDTO](mapper, slowSource)
+		if calls != 0 {
+			t.Errorf("Expected no reports, got %d", calls)
+		}
+	})
+
+	t.Run("ManualMappingsAreNotTimed", func(t *testing.T) {
+		calls := 0
+		mapper := New(WithAutoMapBudget(0, func(reflect.Type, reflect.Type, time.Duration) {
+			calls++
+		}))
+		Register(mapper, func(s Slow) SlowDTO {
+			time.Sleep(time.Millisecond)
+			return SlowDTO{}
+		})
+
+		_, _ = Map[Slow, SlowDTO](mapper, slowSource)
+		if calls != 0 {
+			t.Errorf("Expected manual mappings not to be timed, got %d reports", calls)
+		}
+	})
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		mapper := New()
+		if mapper.config.budget != nil {
+			t.Error("Expected no budget by default")
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package mapper
+
+// Builder configures a Mapper fluently. It centralizes the auto-mapping policy of a mapper,
+// so every RegisterAutoMap call on the built mapper follows the same rules. A Builder is
+// not safe for concurrent use.
+//
+// Example:
+//
+//	mapper := NewBuilder().
+//	    WithTagKey("json").
+//	    IgnoreFields("Password", "InternalID").
+//	    CaseInsensitive().
+//	    Build()
+//
+//	RegisterAutoMap[User, APIUser](mapper)
+type Builder struct {
+	autoMap AutoMapOptions
+	opts    []Option
+}
+
+// NewBuilder returns a Builder that produces a mapper with default settings until
+// configured otherwise.
+//
+// Returns:
+//   - *Builder: A new builder
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// WithTagKey makes auto-maps match fields by the name in the struct tag with the given key,
+// such as "json" or "db", instead of the Go field name. See AutoMapOptions.TagKey.
+//
+// Parameters:
+//   - key: The struct tag key to read field names from
+//
+// Returns:
+//   - *Builder: The builder, for chaining
+func (b *Builder) WithTagKey(key string) *Builder {
+	b.autoMap.TagKey = key
+	return b
+}
+
+// IgnoreFields adds Go field names that auto-maps never copy. It may be called several
+// times; the names accumulate. See AutoMapOptions.IgnoreFields.
+//
+// Parameters:
+//   - names: The field names to ignore
+//
+// Returns:
+//   - *Builder: The builder, for chaining
+func (b *Builder) IgnoreFields(names ...string) *Builder {
+	b.autoMap.IgnoreFields = append(b.autoMap.IgnoreFields, names...)
+	return b
+}
+
+// CaseInsensitive makes auto-maps match field names regardless of case.
+// See AutoMapOptions.CaseInsensitive.
+//
+// Returns:
+//   - *Builder: The builder, for chaining
+func (b *Builder) CaseInsensitive() *Builder {
+	b.autoMap.CaseInsensitive = true
+	return b
+}
+
+// FailOnUnmapped makes MapInto fail when source fields have no destination field.
+// See AutoMapOptions.FailOnUnmapped.
+//
+// Returns:
+//   - *Builder: The builder, for chaining
+func (b *Builder) FailOnUnmapped() *Builder {
+	b.autoMap.FailOnUnmapped = true
+	return b
+}
+
+// With adds options such as WithStats to apply to the built mapper.
+//
+// Parameters:
+//   - opts: The options to apply
+//
+// Returns:
+//   - *Builder: The builder, for chaining
+func (b *Builder) With(opts ...Option) *Builder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Build creates a new mapper with the configured settings. The builder may be reused; each
+// call returns an independent mapper.
+//
+// Returns:
+//   - Mapper: A new mapper carrying the configured defaults
+func (b *Builder) Build() Mapper {
+	opts := append([]Option{WithAutoMapOptions(b.autoMap)}, b.opts...)
+	return New(opts...)
+}
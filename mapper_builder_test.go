@@ -0,0 +1,111 @@
+package mapper
+
+import (
+	"testing"
+)
+
+// TestBuilder tests configuring a mapper through the fluent builder
+func TestBuilder(t *testing.T) {
+	type User struct {
+		ID       int    `db:"user_id"`
+		Name     string `db:"name"`
+		Password string `db:"password"`
+	}
+	type Row struct {
+		UserID   int    `db:"user_id"`
+		FullName string `db:"name"`
+		Password string `db:"password"`
+	}
+
+	t.Run("DefaultsMatchNew", func(t *testing.T) {
+		mapper := NewBuilder().Build()
+		RegisterAutoMap[Person, Person](mapper)
+
+		result, err := Map[Person, Person](mapper, Person{Name: "John"})
+		if err != nil || result.Name != "John" {
+			t.Errorf("Expected {John 0}, got %+v (err: %v)", result, err)
+		}
+	})
+
+	t.Run("TagKey", func(t *testing.T) {
+		mapper := NewBuilder().WithTagKey("db").Build()
+		RegisterAutoMap[User, Row](mapper)
+
+		row, err := Map[User, Row](mapper, User{ID: 7, Name: "John"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if row.UserID != 7 || row.FullName != "John" {
+			t.Errorf("Expected {7 John}, got %+v", row)
+		}
+	})
+
+	t.Run("IgnoreFields", func(t *testing.T) {
+		mapper := NewBuilder().WithTagKey("db").IgnoreFields("Password").Build()
+		RegisterAutoMap[User, Row](mapper)
+
+		row, err := Map[User, Row](mapper, User{ID: 7, Password: "secret"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if row.Password != "" {
+			t.Errorf("Expected Password to be ignored, got %q", row.Password)
+		}
+		if row.UserID != 7 {
+			t.Errorf("Expected UserID 7, got %d", row.UserID)
+		}
+
+		user, err := Map[Row, User](mapper, Row{Password: "secret"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if user.Password != "" {
+			t.Errorf("Expected Password to be ignored in reverse, got %q", user.Password)
+		}
+	})
+
+	t.Run("CaseInsensitive", func(t *testing.T) {
+		type Source struct {
+			UserName string `db:"USERNAME"`
+		}
+		type Dest struct {
+			Username string `db:"username"`
+		}
+
+		sensitive := NewBuilder().WithTagKey("db").Build()
+		RegisterAutoMap[Source, Dest](sensitive)
+		if got := MustMap[Source, Dest](sensitive, Source{UserName: "john"}); got.Username != "" {
+			t.Errorf("Expected no match with case-sensitive tags, got %+v", got)
+		}
+
+		insensitive := NewBuilder().WithTagKey("db").CaseInsensitive().Build()
+		RegisterAutoMap[Source, Dest](insensitive)
+		if got := MustMap[Source, Dest](insensitive, Source{UserName: "john"}); got.Username != "john" {
+			t.Errorf("Expected case-insensitive match, got %+v", got)
+		}
+	})
+
+	t.Run("WithOptions", func(t *testing.T) {
+		mapper := NewBuilder().With(WithStats()).Build()
+		Register(mapper, stringToInt)
+
+		_, _ = Map[string, int](mapper, "hello")
+		if got := Stats(mapper).Hits; got != 1 {
+			t.Errorf("Expected 1 hit, got %d", got)
+		}
+	})
+
+	t.Run("BuildReturnsIndependentMappers", func(t *testing.T) {
+		builder := NewBuilder().IgnoreFields("Password")
+		first := builder.Build()
+		builder.IgnoreFields("Name")
+		second := builder.Build()
+
+		if len(first.config.autoMap.IgnoreFields) != 1 {
+			t.Errorf("Expected first mapper to keep 1 ignored field, got %v", first.config.autoMap.IgnoreFields)
+		}
+		if len(second.config.autoMap.IgnoreFields) != 2 {
+			t.Errorf("Expected second mapper to have 2 ignored fields, got %v", second.config.autoMap.IgnoreFields)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package mapper
+
+// MapChan returns a channel that delivers every value received from in, mapped through
+// the registered S -> D mapping function. The output channel is closed once in is closed
+// and all received values have been delivered, which makes MapChan easy to compose with
+// fan-out/fan-in pipelines.
+//
+// The mapping function is resolved once up front, so a missing registration is reported
+// immediately as ErrNoMapping and no goroutine is started. Values are otherwise mapped
+// like Map does: a nil pointer source yields the default registered with
+// RegisterNilDefault, and post-processing functions run on every mapped value.
+//
+// A value whose mapping fails, such as one rejected by a RegisterE function, is not
+// delivered. Instead onError is called with the value and the error, from the mapping
+// goroutine and before the next value is received, so it may log the failure, count it
+// or forward it to the consumer on another channel. With a nil onError, a failure panics
+// with its error in the mapping goroutine, which crashes the program; pass nil only for
+// mappings that cannot fail.
+//
+// Buffering and goroutine lifetime:
+//   - The output channel is unbuffered: the goroutine maps the next value as soon as it
+//     is received from in, then waits for the consumer to take it. At most one mapped
+//     value is pending, so the pipeline applies backpressure to the producer.
+//   - MapChan starts one goroutine that lives until in is closed and drained. If the
+//     consumer stops reading before that, the goroutine blocks on send and leaks. Always
+//     keep receiving until the output channel is closed, or close in and drain the output.
+//     The same applies if onError blocks, for instance on an unread error channel.
+//
+// Type Parameters:
+//   - S: Source element type
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - in: The channel of source values
+//   - onError: The function called with each value whose mapping fails, or nil
+//
+// Returns:
+//   - <-chan D: A channel delivering the mapped values in input order
+//   - error: ErrNoMapping if no mapping function is registered for S -> D
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(e Event) EventDTO { return EventDTO{ID: e.ID} })
+//
+//	out, err := MapChan[Event, EventDTO](mapper, events, func(e Event, err error) {
+//	    log.Printf("dropping event %d: %v", e.ID, err)
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for dto := range out {
+//	    publish(dto)
+//	}
+func MapChan[S any, D any](m Mapper, in <-chan S, onError func(S, error)) (<-chan D, error) {
+	fn, ok := lookup[S, D](m)
+	if !ok {
+		return nil, ErrNoMapping
+	}
+
+	key := keyOf[S, D]()
+	out := make(chan D)
+	go func() {
+		defer close(out)
+		for src := range in {
+			if def, ok := nilDefaultFor[S, D](m, key, src); ok {
+				out <- def
+				continue
+			}
+			mapped, err := callMapping[S, D](fn, src)
+			if err != nil {
+				if onError == nil {
+					panic(err)
+				}
+				onError(src, err)
+				continue
+			}
+			out <- postProcess(m, src, mapped)
+		}
+	}()
+	return out, nil
+}
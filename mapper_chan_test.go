@@ -0,0 +1,151 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestMapChan tests mapping values received from a channel
+func TestMapChan(t *testing.T) {
+	t.Run("MapsValuesInOrder", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		in := make(chan Person)
+		go func() {
+			defer close(in)
+			in <- Person{Name: "Alice", Age: 20}
+			in <- Person{Name: "Bob", Age: 30}
+			in <- Person{Name: "Carol", Age: 40}
+		}()
+
+		out, err := MapChan[Person, PersonDTO](mapper, in, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var got []PersonDTO
+		for dto := range out {
+			got = append(got, dto)
+		}
+		want := []PersonDTO{{"Alice", 20}, {"Bob", 30}, {"Carol", 40}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("ClosesOutputWhenInputCloses", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		in := make(chan string)
+		close(in)
+
+		out, err := MapChan[string, int](mapper, in, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, ok := <-out; ok {
+			t.Error("Expected output channel to be closed")
+		}
+	})
+
+	t.Run("PointerValues", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		in := make(chan *Person, 2)
+		in <- &Person{Name: "Alice"}
+		in <- nil
+		close(in)
+
+		out, err := MapChan[*Person, *PersonDTO](mapper, in, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		first, second := <-out, <-out
+		if first == nil || first.FullName != "Alice" {
+			t.Errorf("Expected pointer to {Alice 0}, got %v", first)
+		}
+		if second != nil {
+			t.Errorf("Expected nil for nil source, got %v", second)
+		}
+	})
+
+	t.Run("NilDefault", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterNilDefault[*Person](mapper, &PersonDTO{FullName: "unknown"})
+
+		in := make(chan *Person, 2)
+		in <- nil
+		in <- &Person{Name: "Alice"}
+		close(in)
+
+		out, err := MapChan[*Person, *PersonDTO](mapper, in, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		first, second := <-out, <-out
+		if first == nil || first.FullName != "unknown" {
+			t.Errorf("Expected the nil default, got %v", first)
+		}
+		if second == nil || second.FullName != "Alice" {
+			t.Errorf("Expected pointer to {Alice 0}, got %v", second)
+		}
+	})
+
+	t.Run("FailingValueReportedToOnError", func(t *testing.T) {
+		errEmpty := errors.New("empty")
+		mapper := New()
+		RegisterE(mapper, func(s string) (int, error) {
+			if s == "" {
+				return 0, errEmpty
+			}
+			return len(s), nil
+		})
+
+		in := make(chan string, 3)
+		in <- "a"
+		in <- ""
+		in <- "ccc"
+		close(in)
+
+		var failed []string
+		out, err := MapChan[string, int](mapper, in, func(s string, err error) {
+			if !errors.Is(err, errEmpty) {
+				t.Errorf("Expected the mapping error, got %v", err)
+			}
+			failed = append(failed, s)
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var got []int
+		for n := range out {
+			got = append(got, n)
+		}
+		if !reflect.DeepEqual(got, []int{1, 3}) {
+			t.Errorf("Expected [1 3], got %v", got)
+		}
+		if !reflect.DeepEqual(failed, []string{""}) {
+			t.Errorf("Expected the empty string to be reported, got %q", failed)
+		}
+	})
+
+	t.Run("UnregisteredReturnsErrorImmediately", func(t *testing.T) {
+		mapper := New()
+
+		out, err := MapChan[Person, PersonDTO](mapper, make(chan Person), nil)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if out != nil {
+			t.Error("Expected nil channel on error")
+		}
+	})
+}
@@ -42,6 +42,23 @@ func BenchmarkMapStructCompare(b *testing.B) {
 		}
 	})
 
+	b.Run("Handle", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, func(s Source) Dest {
+			return Dest{
+				Name:  s.Name,
+				Age:   s.Age,
+				Email: s.Email,
+			}
+		})
+		toDest, _ := Handle[Source, Dest](mapper)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = toDest.Call(src)
+		}
+	})
+
 	b.Run("AutoMap", func(b *testing.B) {
 		mapper := New()
 		RegisterAutoMap[Source, Dest](mapper)
@@ -93,6 +110,17 @@ func BenchmarkMapSliceCompare(b *testing.B) {
 		}
 	})
 
+	b.Run("HandleSlice", func(b *testing.B) {
+		mapper := New()
+		Register(mapper, func(s Source) Dest {
+			return Dest{ID: s.ID, Value: s.Value}
+		})
+		toDest, _ := Handle[Source, Dest](mapper)
+		for i := 0; i < b.N; i++ {
+			_ = toDest.CallSlice(srcSlice)
+		}
+	})
+
 	b.Run("AutoMapSlice", func(b *testing.B) {
 		mapper := New()
 		RegisterAutoMap[Source, Dest](mapper)
@@ -0,0 +1,85 @@
+package mapper
+
+import (
+	"reflect"
+)
+
+// conditionalMapping is a mapping function that only applies when its predicate matches.
+// Both fields hold functions of the registered S and D types.
+type conditionalMapping struct {
+	pred any
+	fn   any
+}
+
+// RegisterWhen registers a conditional S -> D mapping function that is used only for
+// sources matching pred. It lets one type pair branch on the source value, such as mapping
+// refunded orders to a different receipt, without stuffing that logic into one function.
+//
+// Map tries the conditional mappings of a type pair in registration order and uses the
+// first one whose predicate returns true. If no predicate matches, the default mapping
+// registered with Register is used, and if there is none, Map returns ErrNoMapping.
+// Conditional mappings are consulted by Map and the helpers built on it, such as MustMap
+// and MapToSlice; bulk helpers such as MapSlice always use the default mapping. Has only
+// reports default mappings, and Remove removes a pair's conditional mappings together
+// with its default.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance to register the conditional mapping with
+//   - pred: The predicate selecting the sources this mapping applies to
+//   - fn: The mapping function used when pred returns true
+//
+// Panics:
+//   - If pred or fn is nil, with an error wrapping ErrNilMappingFunc
+//
+// Example:
+//
+//	mapper := New()
+//	RegisterWhen(mapper,
+//	    func(o Order) bool { return o.Refunded },
+//	    func(o Order) Receipt { return Receipt{Total: -o.Total, Note: "refund"} },
+//	)
+//	Register(mapper, func(o Order) Receipt { return Receipt{Total: o.Total} })
+//
+//	receipt, _ := Map[Order, Receipt](mapper, Order{Total: 10, Refunded: true})
+//	fmt.Println(receipt.Note) // Output: refund
+func RegisterWhen[S any, D any](m Mapper, pred func(S) bool, fn func(S) D) {
+	key := keyOf[S, D]()
+	if pred == nil || fn == nil {
+		panic(nilFuncError(typePair{src: typeOf[S](), dst: typeOf[D]()}))
+	}
+
+	if m.config.conditions == nil {
+		m.config.conditions = make(map[typePair][]conditionalMapping)
+	}
+	m.config.conditions[key] = append(m.config.conditions[key], conditionalMapping{pred: pred, fn: fn})
+}
+
+// matchConditional returns the function of the first conditional mapping registered for
+// key whose predicate accepts src.
+func matchConditional[S any](m Mapper, key typePair, src S) (any, bool) {
+	for _, c := range m.config.conditions[key] {
+		if predicateMatches(c.pred, src) {
+			return c.fn, true
+		}
+	}
+	return nil, false
+}
+
+// predicateMatches calls pred with src, adapting between pointer and value forms the same
+// way Map does. A nil pointer source never matches a predicate that takes a value.
+func predicateMatches[S any](pred any, src S) bool {
+	if fn, ok := pred.(func(S) bool); ok {
+		return fn(src)
+	}
+
+	predValue := reflect.ValueOf(pred)
+	arg, ok := adaptArg(predValue.Type().In(0), reflect.ValueOf(src))
+	if !ok {
+		return false
+	}
+	return predValue.Call([]reflect.Value{arg})[0].Bool()
+}
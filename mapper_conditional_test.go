@@ -0,0 +1,117 @@
+package mapper
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestRegisterWhen tests conditional mappings selected by a predicate over the source
+func TestRegisterWhen(t *testing.T) {
+	type Order struct {
+		Total    int
+		Refunded bool
+		Gift     bool
+	}
+	type Receipt struct {
+		Total int
+		Note  string
+	}
+
+	isRefunded := func(o Order) bool { return o.Refunded }
+	isGift := func(o Order) bool { return o.Gift }
+	toRefund := func(o Order) Receipt { return Receipt{Total: -o.Total, Note: "refund"} }
+	toGift := func(o Order) Receipt { return Receipt{Note: "gift"} }
+	toReceipt := func(o Order) Receipt { return Receipt{Total: o.Total, Note: "sale"} }
+
+	newMapper := func() Mapper {
+		mapper := New()
+		RegisterWhen(mapper, isRefunded, toRefund)
+		RegisterWhen(mapper, isGift, toGift)
+		Register(mapper, toReceipt)
+		return mapper
+	}
+
+	t.Run("PredicatesAndDefault", func(t *testing.T) {
+		mapper := newMapper()
+
+		tests := []struct {
+			order Order
+			want  Receipt
+		}{
+			{Order{Total: 10, Refunded: true}, Receipt{Total: -10, Note: "refund"}},
+			{Order{Total: 10, Gift: true}, Receipt{Note: "gift"}},
+			{Order{Total: 10}, Receipt{Total: 10, Note: "sale"}},
+		}
+		for _, tt := range tests {
+			got, err := Map[Order, Receipt](mapper, tt.order)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %+v for %+v, got %+v", tt.want, tt.order, got)
+			}
+		}
+	})
+
+	t.Run("FirstMatchingPredicateWins", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[Order, Receipt](mapper, Order{Total: 10, Refunded: true, Gift: true})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Note != "refund" {
+			t.Errorf("Expected refund, got %q", got.Note)
+		}
+	})
+
+	t.Run("NoMatchWithoutDefaultReturnsError", func(t *testing.T) {
+		mapper := New()
+		RegisterWhen(mapper, isRefunded, toRefund)
+
+		_, err := Map[Order, Receipt](mapper, Order{Total: 10})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("PointerSource", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[*Order, *Receipt](mapper, &Order{Total: 5, Refunded: true})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got == nil || got.Note != "refund" {
+			t.Errorf("Expected pointer to refund receipt, got %+v", got)
+		}
+
+		got, err = Map[*Order, *Receipt](mapper, nil)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil for nil source, got %+v (err: %v)", got, err)
+		}
+	})
+
+	t.Run("RemoveClearsConditions", func(t *testing.T) {
+		mapper := newMapper()
+		Remove[Order, Receipt](mapper)
+
+		_, err := Map[Order, Receipt](mapper, Order{Refunded: true})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("NilFunctionPanics", func(t *testing.T) {
+		mapper := New()
+
+		defer func() {
+			err, ok := recover().(error)
+			if !ok || !errors.Is(err, ErrNilMappingFunc) {
+				t.Errorf("Expected panic with ErrNilMappingFunc, got %v", err)
+			}
+		}()
+
+		RegisterWhen(mapper, nil, toRefund)
+	})
+}
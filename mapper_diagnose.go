@@ -0,0 +1,134 @@
+package mapper
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/jinzhu/copier"
+)
+
+// sampleDepth bounds how deeply AutoMapDiagnose fills nested sample values, so that
+// self-referential types terminate.
+const sampleDepth = 4
+
+// CopierReport describes which destination fields the jinzhu/copier library actually sets
+// when auto-mapping one struct type to another. Field names are Go field names; fields
+// promoted from embedded structs are given by their path, such as "Base.ID".
+type CopierReport struct {
+	// Matched lists the destination fields copier set from the source.
+	Matched []string
+
+	// Unmatched lists the destination fields copier left at their zero value.
+	Unmatched []string
+}
+
+// AutoMapDiagnose reports which fields of D are filled when copier copies an S into it,
+// the way RegisterAutoMap does with default options. It gives an empirical match report
+// rather than a theoretical one: copier-specific behavior, such as filling a field from a
+// source method of the same name or converting between compatible types, shows up exactly
+// as it happens at mapping time.
+//
+// The report is produced by copying a sample S whose exported fields are all set to
+// non-zero values, recursively for nested structs, and checking which destination fields
+// are no longer zero. Fields of interface, channel and function types, and of struct types
+// without exported fields such as time.Time, cannot be sampled and are reported as
+// unmatched unless copier fills them from another source. If S or D is not a struct or a
+// pointer to a struct, the report is empty.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Returns:
+//   - CopierReport: The matched and unmatched destination fields, in declaration order
+//
+// Example:
+//
+//	report := AutoMapDiagnose[User, UserDTO]()
+//	for _, name := range report.Unmatched {
+//	    log.Printf("UserDTO.%s is never auto-mapped", name)
+//	}
+func AutoMapDiagnose[S any, D any]() CopierReport {
+	srcType, dstType := derefType(typeOf[S]()), derefType(typeOf[D]())
+	if srcType.Kind() != reflect.Struct || dstType.Kind() != reflect.Struct {
+		return CopierReport{}
+	}
+
+	src := reflect.New(srcType)
+	fillSample(src.Elem(), sampleDepth)
+	dst := reflect.New(dstType)
+	_ = safeCopy(dst.Interface(), src.Interface(), copier.Option{})
+
+	var report CopierReport
+	for _, f := range mappableFields(dstType) {
+		name := fieldPath(dstType, f.Index)
+		if dst.Elem().FieldByIndex(f.Index).IsZero() {
+			report.Unmatched = append(report.Unmatched, name)
+		} else {
+			report.Matched = append(report.Matched, name)
+		}
+	}
+	return report
+}
+
+// fillSample sets the settable value v to a non-zero sample of its type, descending at
+// most depth levels into structs, pointers, slices, arrays and maps.
+func fillSample(v reflect.Value, depth int) {
+	if depth == 0 {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(1)
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Pointer:
+		ptr := reflect.New(v.Type().Elem())
+		fillSample(ptr.Elem(), depth-1)
+		v.Set(ptr)
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), 1, 1)
+		fillSample(s.Index(0), depth-1)
+		v.Set(s)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fillSample(v.Index(i), depth-1)
+		}
+	case reflect.Map:
+		key := reflect.New(v.Type().Key()).Elem()
+		elem := reflect.New(v.Type().Elem()).Elem()
+		fillSample(key, depth-1)
+		fillSample(elem, depth-1)
+		m := reflect.MakeMap(v.Type())
+		m.SetMapIndex(key, elem)
+		v.Set(m)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			// Exported fields promoted from unexported embedded structs are settable too
+			if f := v.Type().Field(i); f.IsExported() || f.Anonymous && f.Type.Kind() == reflect.Struct {
+				fillSample(v.Field(i), depth-1)
+			}
+		}
+	}
+}
+
+// fieldPath returns the dotted Go field names leading to the field at index in t,
+// following embedded pointers.
+func fieldPath(t reflect.Type, index []int) string {
+	names := make([]string, len(index))
+	for i, idx := range index {
+		f := derefType(t).Field(idx)
+		names[i] = f.Name
+		t = f.Type
+	}
+	return strings.Join(names, ".")
+}
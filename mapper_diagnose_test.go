@@ -0,0 +1,100 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+)
+
+type diagnoseBase struct {
+	ID      int
+	Created string
+}
+
+type diagnoseUser struct {
+	diagnoseBase
+	First string
+	Last  string
+	Age   int
+}
+
+// DiagnoseBase is embedded under an exported name
+type DiagnoseBase struct {
+	ID      int
+	Created string
+}
+
+type DiagnoseAccount struct {
+	DiagnoseBase
+	First string
+	Plan  string
+}
+
+// FullName is picked up by copier for a destination field of the same name
+func (u diagnoseUser) FullName() string {
+	return u.First + " " + u.Last
+}
+
+type diagnoseUserDTO struct {
+	ID       int
+	Created  string
+	First    string
+	FullName string
+	Age      int64
+	Extra    string
+}
+
+// TestAutoMapDiagnose tests the empirical copier match report
+func TestAutoMapDiagnose(t *testing.T) {
+	// planned returns the destination fields the field plan matches for S -> D
+	planned := func(src, dst reflect.Type) []string {
+		var names []string
+		for _, f := range newFieldPlan(src, dst, AutoMapOptions{CaseInsensitive: true}).fields {
+			names = append(names, fieldPath(dst, f.dst))
+		}
+		return names
+	}
+
+	t.Run("UnexportedEmbeddedSource", func(t *testing.T) {
+		report := AutoMapDiagnose[diagnoseUser, diagnoseUserDTO]()
+
+		// copier skips fields promoted from an unexported embedded struct but fills
+		// FullName from the method of the same name
+		want := []string{"First", "FullName", "Age"}
+		if !reflect.DeepEqual(report.Matched, want) {
+			t.Errorf("Expected matched %v, got %v", want, report.Matched)
+		}
+		if !reflect.DeepEqual(report.Unmatched, []string{"ID", "Created", "Extra"}) {
+			t.Errorf("Expected unmatched [ID Created Extra], got %v", report.Unmatched)
+		}
+
+		// The plan sees the promoted fields, but not the FullName method
+		plan := planned(reflect.TypeOf(diagnoseUser{}), reflect.TypeOf(diagnoseUserDTO{}))
+		if !reflect.DeepEqual(plan, []string{"ID", "Created", "First", "Age"}) {
+			t.Errorf("Expected plan [ID Created First Age], got %v", plan)
+		}
+	})
+
+	t.Run("ExportedEmbeddedDestination", func(t *testing.T) {
+		report := AutoMapDiagnose[*diagnoseUserDTO, *DiagnoseAccount]()
+
+		want := []string{"DiagnoseBase.ID", "DiagnoseBase.Created", "First"}
+		if !reflect.DeepEqual(report.Matched, want) {
+			t.Errorf("Expected matched %v, got %v", want, report.Matched)
+		}
+		if !reflect.DeepEqual(report.Unmatched, []string{"Plan"}) {
+			t.Errorf("Expected unmatched [Plan], got %v", report.Unmatched)
+		}
+
+		plan := planned(reflect.TypeOf(diagnoseUserDTO{}), reflect.TypeOf(DiagnoseAccount{}))
+		if !reflect.DeepEqual(plan, want) {
+			t.Errorf("Expected plan %v to agree with copier, got %v", want, plan)
+		}
+	})
+
+	t.Run("NonStructTypes", func(t *testing.T) {
+		report := AutoMapDiagnose[string, int]()
+		if report.Matched != nil || report.Unmatched != nil {
+			t.Errorf("Expected empty report, got %+v", report)
+		}
+	})
+}
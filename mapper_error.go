@@ -0,0 +1,199 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNotAnError is returned by MapError when the mapped value does not implement error.
+var ErrNotAnError = errors.New("mapped value does not implement error")
+
+// errorType is the reflect.Type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// MapError maps an error value to another error type using the mapping registered for the
+// concrete type of err. It bridges the mapper into error-handling middleware, where domain
+// errors modeled as structs are converted into API error responses.
+//
+// The mapping is looked up for the concrete type of err and dstType, with the same pointer
+// normalization as Map, so a *DomainError can be mapped by a function registered for
+// DomainError -> APIError. Only the concrete type of err itself is considered; wrapped
+// errors are not unwrapped.
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - err: The error to map; may be nil
+//   - dstType: The type to map err to; it must implement error
+//
+// Returns:
+//   - error: The mapped error, or nil if err is nil or maps to a nil pointer
+//   - error: ErrNoMapping if no mapping is registered for the concrete type of err,
+//     ErrNotAnError if dstType does not implement error, or an error wrapping
+//     ErrSignatureMismatch if the registered function cannot be called
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(e DomainError) *APIError {
+//	    return &APIError{Status: 404, Message: e.Reason}
+//	})
+//
+//	apiErr, err := MapError(mapper, DomainError{Reason: "not found"}, reflect.TypeOf(&APIError{}))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	writeError(w, apiErr)
+func MapError(m Mapper, err error, dstType reflect.Type) (error, error) {
+	if err == nil {
+		return nil, nil
+	}
+	if !dstType.Implements(errorType) {
+		return nil, fmt.Errorf("%w: %s", ErrNotAnError, dstType)
+	}
+
+	srcValue := reflect.ValueOf(err)
+	fn, ok := m.registry.get(pairOf(srcValue.Type(), dstType))
+	if !ok {
+		return nil, ErrNoMapping
+	}
+
+	mapped, mapErr := mapValue(fn, srcValue, dstType)
+	if mapErr != nil {
+		return nil, mapErr
+	}
+	if !mapped.IsValid() || (mapped.Kind() == reflect.Ptr || mapped.Kind() == reflect.Interface) && mapped.IsNil() {
+		return nil, nil
+	}
+	return mapped.Interface().(error), nil
+}
+
+// mapValue calls the mapping function fn with src and converts the result to dstType,
+// handling pointer and value forms on both sides like Map. A nil pointer source that
+// cannot be passed to fn yields the zero value of dstType.
+func mapValue(fn any, src reflect.Value, dstType reflect.Type) (_ reflect.Value, err error) {
+	defer recoverSignatureMismatch(src.Type(), dstType, &err)
+
+	fnValue := reflect.ValueOf(fn)
+	arg, ok := adaptArg(fnValue.Type().In(0), src)
+	if !ok {
+		return reflect.Zero(dstType), nil
+	}
+
+	return adaptResult(fnValue.Call([]reflect.Value{arg})[0], dstType), nil
+}
+
+// adaptResult converts a mapped value to dstType, taking its address or dereferencing it
+// as needed. A nil pointer that must be dereferenced yields the zero value of dstType.
+func adaptResult(result reflect.Value, dstType reflect.Type) reflect.Value {
+	switch {
+	case dstType.Kind() == reflect.Interface:
+	case dstType.Kind() == reflect.Ptr && result.Kind() != reflect.Ptr:
+		ptr := reflect.New(result.Type())
+		ptr.Elem().Set(result)
+		result = ptr
+	case dstType.Kind() != reflect.Ptr && result.Kind() == reflect.Ptr:
+		if result.IsNil() {
+			return reflect.Zero(dstType)
+		}
+		result = result.Elem()
+	}
+	return result.Convert(dstType)
+}
+
+// ErrorWrapper is implemented by mapped errors that can chain the error they were mapped
+// from. MapErrorWrap calls Wrap with the original error after mapping; the implementation
+// is expected to store it and return it from an Unwrap() error method, so that errors.Is
+// and errors.As see through the mapped error to the original. Wrap is called on the
+// mapped value itself, so a pointer receiver implementation requires the mapping to
+// produce a pointer, as in func(NotFound) *APIError.
+type ErrorWrapper interface {
+	Wrap(err error)
+}
+
+// RegisterErrorMap registers fn as the mapping for errors of type S, like Register, and
+// records D as the type MapErrorWrap maps S errors to. It suits the boundary between a
+// domain layer and a transport layer, where each domain error type has one transport
+// representation.
+//
+// A source type has one error mapping at a time: registering another D for the same S
+// replaces the destination MapErrorWrap uses, but the earlier mapping stays registered
+// for Map and MapError. Remove clears the registration together with the mapping.
+//
+// Type Parameters:
+//   - S: The source error type, such as NotFound or *NotFound
+//   - D: The mapped error type; implement ErrorWrapper on it to chain the original
+//
+// Parameters:
+//   - m: The mapper instance to register the mapping with
+//   - fn: The function converting S errors to D
+//
+// Example:
+//
+//	mapper := New()
+//	RegisterErrorMap(mapper, func(e NotFound) *APIError {
+//	    return &APIError{Status: 404, Message: e.Error()}
+//	})
+func RegisterErrorMap[S error, D error](m Mapper, fn func(S) D) {
+	Register(m, fn)
+	if m.config.errorMaps == nil {
+		m.config.errorMaps = make(map[reflect.Type]reflect.Type)
+	}
+	m.config.errorMaps[derefType(typeOf[S]())] = typeOf[D]()
+}
+
+// MapErrorWrap maps err to the type registered for its concrete type with
+// RegisterErrorMap, then chains err into the result if it implements ErrorWrapper. The
+// mapped error then unwraps to err, so errors.Is(mapped, err) holds and sentinel checks
+// written against domain errors keep working on transport errors. A result that does not
+// implement ErrorWrapper is returned as mapped.
+//
+// Like MapError, only the concrete type of err is considered; wrapped errors are not
+// unwrapped to find a registration. A *NotFound is mapped by a registration for NotFound
+// and the other way around.
+//
+// Parameters:
+//   - m: The mapper instance containing the RegisterErrorMap registrations
+//   - err: The error to map; may be nil
+//
+// Returns:
+//   - error: The mapped error, or nil if err is nil or maps to a nil pointer
+//   - error: ErrNoMapping if RegisterErrorMap was not called for the concrete type of err,
+//     or the error MapError returns
+//
+// Example:
+//
+//	type APIError struct {
+//	    Status  int
+//	    Message string
+//	    cause   error
+//	}
+//
+//	func (e *APIError) Error() string  { return e.Message }
+//	func (e *APIError) Wrap(err error) { e.cause = err }
+//	func (e *APIError) Unwrap() error  { return e.cause }
+//
+//	apiErr, err := MapErrorWrap(mapper, NotFound{ID: 7})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(errors.Is(apiErr, NotFound{ID: 7})) // Output: true
+func MapErrorWrap(m Mapper, err error) (error, error) {
+	if err == nil {
+		return nil, nil
+	}
+
+	dstType, ok := m.config.errorMaps[derefType(reflect.TypeOf(err))]
+	if !ok {
+		return nil, ErrNoMapping
+	}
+
+	mapped, mapErr := MapError(m, err, dstType)
+	if mapErr != nil || mapped == nil {
+		return mapped, mapErr
+	}
+	if w, ok := mapped.(ErrorWrapper); ok {
+		w.Wrap(err)
+	}
+	return mapped, nil
+}
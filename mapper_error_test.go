@@ -0,0 +1,204 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// DomainError is a domain-level error used to test MapError
+type DomainError struct {
+	Reason string
+}
+
+func (e DomainError) Error() string { return e.Reason }
+
+// APIError is an API-level error used to test MapError
+type APIError struct {
+	Status  int
+	Message string
+}
+
+func (e *APIError) Error() string { return e.Message }
+
+// TestMapError tests mapping error values through the registry
+func TestMapError(t *testing.T) {
+	toAPIError := func(e DomainError) APIError { return APIError{Status: 404, Message: e.Reason} }
+	apiErrorType := reflect.TypeOf(&APIError{})
+
+	t.Run("MapsConcreteErrorType", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toAPIError)
+
+		mapped, err := MapError(mapper, DomainError{Reason: "not found"}, apiErrorType)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var apiErr *APIError
+		if !errors.As(mapped, &apiErr) {
+			t.Fatalf("Expected *APIError, got %T", mapped)
+		}
+		if apiErr.Status != 404 || apiErr.Message != "not found" {
+			t.Errorf("Expected {404 not found}, got %+v", apiErr)
+		}
+	})
+
+	t.Run("PointerSourceError", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toAPIError)
+
+		mapped, err := MapError(mapper, &DomainError{Reason: "gone"}, apiErrorType)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if mapped.Error() != "gone" {
+			t.Errorf("Expected gone, got %v", mapped)
+		}
+	})
+
+	t.Run("InterfaceDestination", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(e DomainError) error { return &APIError{Status: 400, Message: e.Reason} })
+
+		mapped, err := MapError(mapper, DomainError{Reason: "bad"}, errorType)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if apiErr, ok := mapped.(*APIError); !ok || apiErr.Status != 400 {
+			t.Errorf("Expected *APIError with status 400, got %#v", mapped)
+		}
+	})
+
+	t.Run("NilErrorReturnsNil", func(t *testing.T) {
+		mapper := New()
+
+		mapped, err := MapError(mapper, nil, apiErrorType)
+		if mapped != nil || err != nil {
+			t.Errorf("Expected nil, nil, got %v, %v", mapped, err)
+		}
+	})
+
+	t.Run("UnregisteredReturnsErrNoMapping", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapError(mapper, DomainError{Reason: "x"}, apiErrorType)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("DestinationMustImplementError", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(e DomainError) string { return e.Reason })
+
+		_, err := MapError(mapper, DomainError{Reason: "x"}, reflect.TypeOf(""))
+		if !errors.Is(err, ErrNotAnError) {
+			t.Errorf("Expected ErrNotAnError, got %v", err)
+		}
+	})
+}
+
+// wrappingAPIError is an API-level error that chains the error it was mapped from
+type wrappingAPIError struct {
+	Status int
+	cause  error
+}
+
+func (e *wrappingAPIError) Error() string { return "api error" }
+
+func (e *wrappingAPIError) Wrap(err error) { e.cause = err }
+
+func (e *wrappingAPIError) Unwrap() error { return e.cause }
+
+// TestMapErrorWrap tests mapping errors registered with RegisterErrorMap
+func TestMapErrorWrap(t *testing.T) {
+	toWrapping := func(e DomainError) *wrappingAPIError { return &wrappingAPIError{Status: 404} }
+	errSentinel := errors.New("sentinel")
+
+	t.Run("ChainsOriginal", func(t *testing.T) {
+		mapper := New()
+		RegisterErrorMap(mapper, toWrapping)
+
+		src := DomainError{Reason: "not found"}
+		mapped, err := MapErrorWrap(mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !errors.Is(mapped, src) {
+			t.Errorf("Expected mapped error to wrap %v, got %v", src, mapped)
+		}
+		var domainErr DomainError
+		if !errors.As(mapped, &domainErr) || domainErr.Reason != "not found" {
+			t.Errorf("Expected errors.As to find the DomainError, got %+v", domainErr)
+		}
+		if apiErr, ok := mapped.(*wrappingAPIError); !ok || apiErr.Status != 404 {
+			t.Errorf("Expected *wrappingAPIError with status 404, got %#v", mapped)
+		}
+	})
+
+	t.Run("PointerSourceChainsSentinel", func(t *testing.T) {
+		mapper := New()
+		RegisterErrorMap(mapper, func(e *sentinelError) *wrappingAPIError { return &wrappingAPIError{Status: 500} })
+
+		mapped, err := MapErrorWrap(mapper, &sentinelError{err: errSentinel})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !errors.Is(mapped, errSentinel) {
+			t.Errorf("Expected errors.Is to reach the sentinel through the chain, got %v", mapped)
+		}
+	})
+
+	t.Run("NonWrapperReturnedAsMapped", func(t *testing.T) {
+		mapper := New()
+		RegisterErrorMap(mapper, func(e DomainError) *APIError { return &APIError{Status: 400, Message: e.Reason} })
+
+		mapped, err := MapErrorWrap(mapper, DomainError{Reason: "bad"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if errors.Unwrap(mapped) != nil || mapped.Error() != "bad" {
+			t.Errorf("Expected an unwrapped *APIError, got %#v", mapped)
+		}
+	})
+
+	t.Run("RequiresRegisterErrorMap", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toWrapping)
+
+		_, err := MapErrorWrap(mapper, DomainError{})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("RemoveClearsRegistration", func(t *testing.T) {
+		mapper := New()
+		RegisterErrorMap(mapper, toWrapping)
+		Remove[DomainError, wrappingAPIError](mapper)
+
+		_, err := MapErrorWrap(mapper, DomainError{})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping after Remove, got %v", err)
+		}
+	})
+
+	t.Run("NilErrorReturnsNil", func(t *testing.T) {
+		mapper := New()
+
+		mapped, err := MapErrorWrap(mapper, nil)
+		if mapped != nil || err != nil {
+			t.Errorf("Expected nil, nil, got %v, %v", mapped, err)
+		}
+	})
+}
+
+// sentinelError wraps a sentinel error, for testing chains through mapped errors
+type sentinelError struct {
+	err error
+}
+
+func (e *sentinelError) Error() string { return e.err.Error() }
+
+func (e *sentinelError) Unwrap() error { return e.err }
@@ -0,0 +1,69 @@
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SetFallback installs a last-resort mapping function that Map consults when no specific
+// mapping is registered for the requested type pair. This makes it possible to plug in a
+// generic reflective copier or a JSON round-trip as the default behavior.
+//
+// The fallback receives the source value boxed in an interface and the exact destination
+// type requested by the caller (including any pointer indirection). Its result must be
+// assignable to the destination type, as a []string is to a named slice type, though it
+// need not be of that exact type; otherwise Map returns ErrFallbackResultType.
+// A nil result is treated as the zero value of the destination type.
+//
+// Passing a nil function removes a previously installed fallback. The fallback is only
+// consulted by Map; MapSlice still reports ErrNoMapping for unregistered element types.
+//
+// Parameters:
+//   - m: The mapper instance to install the fallback on
+//   - fn: The fallback function, or nil to remove it
+//
+// Example:
+//
+//	mapper := New()
+//	SetFallback(mapper, func(src any, dstType reflect.Type) (any, error) {
+//	    data, err := json.Marshal(src)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    dst := reflect.New(dstType)
+//	    if err := json.Unmarshal(data, dst.Interface()); err != nil {
+//	        return nil, err
+//	    }
+//	    return dst.Elem().Interface(), nil
+//	})
+//
+//	dto, err := Map[User, UserDTO](mapper, user) // served by the fallback
+func SetFallback(m Mapper, fn func(src any, dstType reflect.Type) (any, error)) {
+	m.config.fallback = fn
+}
+
+// mapFallback runs the mapper's fallback function and converts its result to D.
+func mapFallback[S any, D any](m Mapper, src S) (D, error) {
+	var dst D
+
+	dstType := typeOf[D]()
+	result, err := m.config.fallback(src, dstType)
+	if err != nil {
+		return dst, err
+	}
+	if result == nil {
+		return dst, nil
+	}
+
+	if typed, ok := result.(D); ok {
+		return typed, nil
+	}
+	// A result of another type may still be assignable, such as a []string for a
+	// destination of a named slice type
+	value := reflect.ValueOf(result)
+	if !value.Type().AssignableTo(dstType) {
+		return dst, fmt.Errorf("%w: got %T, want %s", ErrFallbackResultType, result, dstType)
+	}
+	reflect.ValueOf(&dst).Elem().Set(value)
+	return dst, nil
+}
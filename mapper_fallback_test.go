@@ -0,0 +1,141 @@
+package mapper
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// jsonFallback maps any value by round-tripping it through JSON
+func jsonFallback(src any, dstType reflect.Type) (any, error) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+	dst := reflect.New(dstType)
+	if err := json.Unmarshal(data, dst.Interface()); err != nil {
+		return nil, err
+	}
+	return dst.Elem().Interface(), nil
+}
+
+// TestSetFallback tests the catch-all fallback mapping function
+func TestSetFallback(t *testing.T) {
+	type User struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+	type UserDTO struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	t.Run("FallbackUsedWhenNoMapping", func(t *testing.T) {
+		mapper := New()
+		SetFallback(mapper, jsonFallback)
+
+		result, err := Map[User, UserDTO](mapper, User{Name: "John", Age: 30})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Name != "John" || result.Age != 30 {
+			t.Errorf("Expected {John 30}, got %+v", result)
+		}
+	})
+
+	t.Run("FallbackReceivesPointerDestinationType", func(t *testing.T) {
+		mapper := New()
+		SetFallback(mapper, jsonFallback)
+
+		result, err := Map[User, *UserDTO](mapper, User{Name: "Jane", Age: 25})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result == nil || result.Name != "Jane" || result.Age != 25 {
+			t.Errorf("Expected &{Jane 25}, got %+v", result)
+		}
+	})
+
+	t.Run("RegisteredMappingTakesPrecedence", func(t *testing.T) {
+		mapper := New()
+		SetFallback(mapper, func(src any, dstType reflect.Type) (any, error) {
+			t.Error("Fallback should not be called for registered mappings")
+			return nil, nil
+		})
+		Register(mapper, stringToInt)
+
+		result, err := Map[string, int](mapper, "hello")
+		if err != nil || result != 5 {
+			t.Errorf("Expected 5, got %d (err: %v)", result, err)
+		}
+	})
+
+	t.Run("FallbackErrorIsReturned", func(t *testing.T) {
+		mapper := New()
+		fallbackErr := errors.New("cannot convert")
+		SetFallback(mapper, func(src any, dstType reflect.Type) (any, error) {
+			return nil, fallbackErr
+		})
+
+		_, err := Map[User, UserDTO](mapper, User{})
+		if !errors.Is(err, fallbackErr) {
+			t.Errorf("Expected fallback error, got %v", err)
+		}
+	})
+
+	t.Run("FallbackWrongTypeReturnsError", func(t *testing.T) {
+		mapper := New()
+		SetFallback(mapper, func(src any, dstType reflect.Type) (any, error) {
+			return "not a dto", nil
+		})
+
+		_, err := Map[User, UserDTO](mapper, User{})
+		if !errors.Is(err, ErrFallbackResultType) {
+			t.Errorf("Expected ErrFallbackResultType, got %v", err)
+		}
+	})
+
+	t.Run("FallbackAssignableResultIsAccepted", func(t *testing.T) {
+		type Tags []string
+
+		mapper := New()
+		SetFallback(mapper, func(src any, dstType reflect.Type) (any, error) {
+			return []string{"go", "mapper"}, nil
+		})
+
+		result, err := Map[string, Tags](mapper, "go,mapper")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result) != 2 || result[0] != "go" || result[1] != "mapper" {
+			t.Errorf("Expected [go mapper], got %v", result)
+		}
+	})
+
+	t.Run("FallbackNilResultReturnsZeroValue", func(t *testing.T) {
+		mapper := New()
+		SetFallback(mapper, func(src any, dstType reflect.Type) (any, error) {
+			return nil, nil
+		})
+
+		result, err := Map[User, *UserDTO](mapper, User{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result != nil {
+			t.Errorf("Expected nil result, got %+v", result)
+		}
+	})
+
+	t.Run("RemoveFallback", func(t *testing.T) {
+		mapper := New()
+		SetFallback(mapper, jsonFallback)
+		SetFallback(mapper, nil)
+
+		_, err := Map[User, UserDTO](mapper, User{})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping after removing fallback, got %v", err)
+		}
+	})
+}
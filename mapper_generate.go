@@ -0,0 +1,135 @@
+package mapper
+
+import (
+	"fmt"
+	"go/format"
+	"reflect"
+	"strings"
+)
+
+// GenerateSource returns Go source for a hand-written S -> D mapping function covering the
+// fields RegisterAutoMap matches with default options. It lets a hot auto-mapping be
+// "graduated" into a plain function: run it once, paste the result and pass it to Register
+// instead of RegisterAutoMap, removing the reflection overhead.
+//
+// The generated function literal assigns each matched field directly when the types are
+// assignable, and through a conversion between numeric types, or between string or bool
+// types, such as a named string type and string. Matched fields that need more than that,
+// such as nested structs or slices of different element types, and fields promoted
+// through embedded pointers on either side are listed in TODO comments for completion by
+// hand. Fields promoted from embedded structs are set after the composite literal, which
+// cannot name them. A pointer S yields a nil check; a pointer D yields a newly allocated
+// value.
+//
+// Types are written as reflect prints them, qualified by their package name, such as
+// mapper.Person; drop the qualifier when pasting into the package declaring the type. The
+// code is built by reflection only and never compiled.
+//
+// Type Parameters:
+//   - S: Source type; a struct or a pointer to a struct
+//   - D: Destination type; a struct or a pointer to a struct
+//
+// Returns:
+//   - string: The gofmt-formatted function literal
+//   - error: An error wrapping ErrAutoMapUnsupported if S or D is not a struct or a
+//     pointer to a struct
+//
+// Example:
+//
+//	src, err := GenerateSource[User, UserDTO]()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(src)
+//	// Output:
+//	// func(s main.User) main.UserDTO {
+//	// 	return main.UserDTO{
+//	// 		ID:   s.ID,
+//	// 		Name: s.Name,
+//	// 	}
+//	// }
+func GenerateSource[S any, D any]() (string, error) {
+	srcType, dstType := typeOf[S](), typeOf[D]()
+	plan := newFieldPlan(srcType, dstType, AutoMapOptions{})
+	if plan == nil {
+		return "", fmt.Errorf("%w: %s->%s", ErrAutoMapUnsupported, srcType, dstType)
+	}
+	srcStruct, dstStruct := derefType(srcType), derefType(dstType)
+
+	var literal, promoted, notes []string
+	for _, fc := range plan.fields {
+		sf, df := srcStruct.FieldByIndex(fc.src), dstStruct.FieldByIndex(fc.dst)
+		if throughPointer(srcStruct, fc.src) || throughPointer(dstStruct, fc.dst) {
+			notes = append(notes, fmt.Sprintf("%s: promoted through an embedded pointer", fieldPath(dstStruct, fc.dst)))
+			continue
+		}
+		expr, ok := fieldExpr("s."+sf.Name, sf.Type, df.Type)
+		if !ok {
+			notes = append(notes, fmt.Sprintf("%s: cannot assign %s to %s", df.Name, sf.Type, df.Type))
+			continue
+		}
+		if len(fc.dst) > 1 {
+			promoted = append(promoted, fmt.Sprintf("d.%s = %s\n", df.Name, expr))
+		} else {
+			literal = append(literal, fmt.Sprintf("%s: %s,\n", df.Name, expr))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "func(s %s) %s {\n", srcType, dstType)
+	if srcType.Kind() == reflect.Pointer {
+		zero := "nil"
+		if dstType.Kind() != reflect.Pointer {
+			zero = dstType.String() + "{}"
+		}
+		fmt.Fprintf(&b, "if s == nil {\nreturn %s\n}\n", zero)
+	}
+	for _, note := range notes {
+		fmt.Fprintf(&b, "// TODO %s\n", note)
+	}
+	amp := ""
+	if dstType.Kind() == reflect.Pointer {
+		amp = "&"
+	}
+	if len(promoted) > 0 {
+		fmt.Fprintf(&b, "d := %s%s{\n%s}\n%sreturn d\n", amp, dstStruct, strings.Join(literal, ""), strings.Join(promoted, ""))
+	} else {
+		fmt.Fprintf(&b, "return %s%s{\n%s}\n", amp, dstStruct, strings.Join(literal, ""))
+	}
+	b.WriteString("}\n")
+
+	// A leading func is parsed as a declaration, so format the literal as a variable value
+	const header = "package p\n\nvar _ = "
+	out, err := format.Source([]byte(header + b.String()))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(string(out), header), nil
+}
+
+// fieldExpr returns the expression converting the value at path of type src to dst, and
+// false if a plain assignment or conversion cannot do it.
+func fieldExpr(path string, src, dst reflect.Type) (string, bool) {
+	if src.AssignableTo(dst) {
+		return path, true
+	}
+	if c := basicClass(src.Kind()); c != "" && c == basicClass(dst.Kind()) {
+		return fmt.Sprintf("%s(%s)", dst, path), true
+	}
+	return "", false
+}
+
+// basicClass groups the basic kinds that convert into each other by value: "bool",
+// "number" or "string". It returns "" for other kinds, so that an int is never converted
+// to a string, which Go would read as a rune.
+func basicClass(k reflect.Kind) string {
+	switch {
+	case k == reflect.Bool:
+		return "bool"
+	case k >= reflect.Int && k <= reflect.Float64:
+		return "number"
+	case k == reflect.String:
+		return "string"
+	}
+	return ""
+}
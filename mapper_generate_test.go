@@ -0,0 +1,107 @@
+package mapper
+
+import (
+	"errors"
+	"go/parser"
+	"testing"
+)
+
+type (
+	genStatus string
+
+	genBase struct {
+		ID int
+	}
+
+	genUser struct {
+		genBase
+		Name    string
+		Age     int
+		Status  genStatus
+		Friends []Person
+		Secret  string
+	}
+
+	genUserDTO struct {
+		genBase
+		Name    string
+		Age     int64
+		Status  string
+		Friends []PersonDTO
+		Email   string
+	}
+)
+
+// TestGenerateSource tests generating mapping source from matched fields
+func TestGenerateSource(t *testing.T) {
+	t.Run("SimpleStruct", func(t *testing.T) {
+		got, err := GenerateSource[Person, Person]()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := `func(s mapper.Person) mapper.Person {
+	return mapper.Person{
+		Name: s.Name,
+		Age:  s.Age,
+	}
+}
+`
+		if got != want {
+			t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+		}
+		if _, err := parser.ParseExpr(got); err != nil {
+			t.Errorf("Generated source does not parse: %v", err)
+		}
+	})
+
+	t.Run("ConversionsAndNotes", func(t *testing.T) {
+		got, err := GenerateSource[*genUser, *genUserDTO]()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := `func(s *mapper.genUser) *mapper.genUserDTO {
+	if s == nil {
+		return nil
+	}
+	// TODO Friends: cannot assign []mapper.Person to []mapper.PersonDTO
+	d := &mapper.genUserDTO{
+		Name:   s.Name,
+		Age:    int64(s.Age),
+		Status: string(s.Status),
+	}
+	d.ID = s.ID
+	return d
+}
+`
+		if got != want {
+			t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+		}
+		if _, err := parser.ParseExpr(got); err != nil {
+			t.Errorf("Generated source does not parse: %v", err)
+		}
+	})
+
+	t.Run("PointerSourceValueDestination", func(t *testing.T) {
+		got, err := GenerateSource[*Person, EmptyStruct]()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := `func(s *mapper.Person) mapper.EmptyStruct {
+	if s == nil {
+		return mapper.EmptyStruct{}
+	}
+	return mapper.EmptyStruct{}
+}
+`
+		if got != want {
+			t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+		}
+	})
+
+	t.Run("NonStructIsUnsupported", func(t *testing.T) {
+		_, err := GenerateSource[string, Person]()
+		if !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported, got %v", err)
+		}
+	})
+}
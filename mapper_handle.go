@@ -0,0 +1,150 @@
+package mapper
+
+import (
+	"reflect"
+)
+
+// MapHandle is the mapping function for the type pair S -> D resolved ahead of time by
+// Handle. Its methods map values like Map without looking anything up in the mapper.
+//
+// Call invokes a function registered with Register directly. Functions that can fail,
+// namely auto-mappings, RegisterE functions and the built-in conversions that parse or
+// convert values, are called under a deferred recover that turns their failure into a
+// panic with its error, as do functions that must be called through reflection.
+//
+// The zero MapHandle has no mapping function; calling its methods panics.
+type MapHandle[S any, D any] struct {
+	// fn maps one value and panics with a mappingFailure if the mapping fails
+	fn func(S) D
+	// canFail is set when fn may panic with a mappingFailure, which Call must recover
+	canFail bool
+}
+
+// Handle resolves the mapping function registered for S -> D once and returns it as a
+// typed MapHandle. It suits tight loops that map many values of one pair: calling the
+// handle skips the registry lookup, the type assertion, the conditional mappings and the
+// statistics that Map goes through on every call.
+//
+// The handle captures the registry entry and the nil default registered with
+// RegisterNilDefault at the time Handle is called; later registrations, overrides and
+// removals do not affect it. Conditional mappings registered with RegisterWhen, the
+// fallback installed with SetFallback and post-processing functions registered with
+// RegisterPostProcess are not used, and calls are not counted by the mapper's statistics.
+// Otherwise the handle behaves like Map: a nil pointer source maps to the nil default or
+// the zero value without calling the mapping function, and value and pointer forms are
+// adapted as needed.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//
+// Returns:
+//   - MapHandle[S, D]: The resolved mapping function
+//   - error: ErrNoMapping if no mapping function is registered for S -> D, or an error
+//     wrapping ErrSignatureMismatch if the registered function cannot convert S to D
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(u User) UserDTO { return UserDTO{Name: u.Name} })
+//
+//	toDTO, err := Handle[User, UserDTO](mapper)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, u := range users {
+//	    fmt.Println(toDTO.Call(u).Name)
+//	}
+func Handle[S any, D any](m Mapper) (MapHandle[S, D], error) {
+	if err := Prepare[S, D](m); err != nil {
+		return MapHandle[S, D]{}, err
+	}
+	key := keyOf[S, D]()
+	state := m.registry.state.Load()
+	fn := state.entries[key]
+	canFail := state.kinds[key].canFail()
+
+	srcType, dstType := typeOf[S](), typeOf[D]()
+	def := m.config.nilDefaults[key]
+
+	direct, ok := fn.(func(S) D)
+	switch {
+	case ok && srcType.Kind() != reflect.Pointer:
+		return MapHandle[S, D]{fn: direct, canFail: canFail}, nil
+	case ok:
+		return MapHandle[S, D]{fn: func(src S) D {
+			if reflect.ValueOf(src).IsNil() {
+				return valueAs[D](nilResult(def, dstType))
+			}
+			return direct(src)
+		}, canFail: canFail}, nil
+	}
+
+	return MapHandle[S, D]{fn: func(src S) D {
+		if srcType.Kind() == reflect.Pointer && reflect.ValueOf(src).IsNil() {
+			return valueAs[D](nilResult(def, dstType))
+		}
+		return mapWithReflection[S, D](fn, src)
+	}, canFail: true}, nil
+}
+
+// Call maps src to D.
+//
+// Parameters:
+//   - src: The source value to be mapped
+//
+// Returns:
+//   - D: The mapped destination value
+//
+// Panics:
+//   - If mapping fails, with the error Map would return, like MustMap
+func (h MapHandle[S, D]) Call(src S) D {
+	if h.canFail {
+		return h.callRecovering(src)
+	}
+	return h.fn(src)
+}
+
+// callRecovering calls fn for Call, re-raising a mapping failure with mustRecover.
+func (h MapHandle[S, D]) callRecovering(src S) D {
+	defer mustRecover()
+	return h.fn(src)
+}
+
+// CallSlice maps each element of src to D, preserving order. A nil slice maps to nil and
+// an empty slice to an empty, non-nil one, as with MapSlice.
+//
+// Parameters:
+//   - src: The source elements to be mapped
+//
+// Returns:
+//   - []D: The mapped elements
+//
+// Panics:
+//   - If mapping an element fails, with the error Map would return, like MustMap
+func (h MapHandle[S, D]) CallSlice(src []S) []D {
+	if src == nil {
+		return nil
+	}
+
+	defer mustRecover()
+	out := make([]D, len(src))
+	for i, v := range src {
+		out[i] = h.fn(v)
+	}
+	return out
+}
+
+// mustRecover re-raises a failMapping panic with its error, the way MustMap panics, and
+// propagates any other panic unchanged.
+func mustRecover() {
+	if r := recover(); r != nil {
+		if f, ok := r.(mappingFailure); ok {
+			panic(f.err)
+		}
+		panic(r)
+	}
+}
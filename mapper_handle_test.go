@@ -0,0 +1,168 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/copier"
+)
+
+// TestHandle tests resolving a mapping function ahead of time
+func TestHandle(t *testing.T) {
+	t.Run("MapsLikeMap", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		toDTO, err := Handle[Person, PersonDTO](mapper)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got := toDTO.Call(Person{Name: "Ann", Age: 30})
+		want, _ := Map[Person, PersonDTO](mapper, Person{Name: "Ann", Age: 30})
+		if got != want {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("PointerForms", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		toDTO, err := Handle[*Person, *PersonDTO](mapper)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := toDTO.Call(&Person{Name: "Ann", Age: 30}); got == nil || got.FullName != "Ann" {
+			t.Errorf("Expected mapped pointer, got %v", got)
+		}
+		if got := toDTO.Call(nil); got != nil {
+			t.Errorf("Expected nil for nil source, got %v", got)
+		}
+	})
+
+	t.Run("NilDefault", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(p *Person) PersonDTO { return PersonDTO{FullName: p.Name} })
+		RegisterNilDefault[*Person](mapper, PersonDTO{FullName: "unknown"})
+
+		toDTO, err := Handle[*Person, PersonDTO](mapper)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := toDTO.Call(nil); got.FullName != "unknown" {
+			t.Errorf("Expected unknown, got %q", got.FullName)
+		}
+	})
+
+	t.Run("CapturesRegistration", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		toDTO, _ := Handle[Person, PersonDTO](mapper)
+		Register(mapper, func(p Person) PersonDTO { return PersonDTO{FullName: "replaced"} })
+
+		if got := toDTO.Call(Person{Name: "Ann"}); got.FullName != "Ann" {
+			t.Errorf("Expected Ann, got %q", got.FullName)
+		}
+	})
+
+	t.Run("MappingFailurePanicsWithError", func(t *testing.T) {
+		mapper := New()
+		errBoom := errors.New("boom")
+		RegisterE(mapper, func(p Person) (PersonDTO, error) { return PersonDTO{}, errBoom })
+		toDTO, _ := Handle[Person, PersonDTO](mapper)
+
+		defer func() {
+			if err, _ := recover().(error); !errors.Is(err, errBoom) {
+				t.Errorf("Expected panic with boom, got %v", err)
+			}
+		}()
+		toDTO.Call(Person{})
+	})
+
+	t.Run("RecoversOnlyFallibleFunctions", func(t *testing.T) {
+		type Source struct{ Scores map[string]int }
+		type Dest struct{ Scores map[int]int }
+
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterTimeConversions(mapper, nil, "")
+		RegisterAutoMap[Source, Dest](mapper)
+
+		if plain, _ := Handle[Person, PersonDTO](mapper); plain.canFail {
+			t.Error("Expected a Register function to be called without recovering")
+		}
+		if parse, _ := Handle[string, time.Time](mapper); !parse.canFail {
+			t.Error("Expected the time parsing function to be recovered")
+		}
+
+		toDest, _ := Handle[Source, Dest](mapper)
+		defer func() {
+			if err, _ := recover().(error); !errors.Is(err, copier.ErrMapKeyNotMatch) {
+				t.Errorf("Expected panic with copier.ErrMapKeyNotMatch, got %v", err)
+			}
+		}()
+		toDest.Call(Source{Scores: map[string]int{"math": 9}})
+	})
+
+	t.Run("FallibleKindFollowsEntry", func(t *testing.T) {
+		mapper := New()
+		RegisterE(mapper, func(p Person) (PersonDTO, error) { return personToDTO(p), nil })
+
+		WithOverride(mapper, personToDTO, func() {
+			if plain, _ := Handle[Person, PersonDTO](mapper); plain.canFail {
+				t.Error("Expected the overriding Register function to be called without recovering")
+			}
+		})
+		if restored, _ := Handle[Person, PersonDTO](mapper); !restored.canFail {
+			t.Error("Expected the restored RegisterE function to be recovered")
+		}
+
+		Remove[Person, PersonDTO](mapper)
+		Register(mapper, personToDTO)
+		if plain, _ := Handle[Person, PersonDTO](mapper); plain.canFail {
+			t.Error("Expected a function registered after Remove to be called without recovering")
+		}
+	})
+
+	t.Run("UnregisteredReturnsErrNoMapping", func(t *testing.T) {
+		if _, err := Handle[Person, PersonDTO](New()); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("MismatchedFunctionReturnsError", func(t *testing.T) {
+		mapper := New()
+		mapper.registry.set(keyOf[Person, PersonDTO](), stringToInt)
+
+		if _, err := Handle[Person, PersonDTO](mapper); !errors.Is(err, ErrSignatureMismatch) {
+			t.Errorf("Expected ErrSignatureMismatch, got %v", err)
+		}
+	})
+}
+
+// TestHandleCallSlice tests mapping slices through a resolved handle
+func TestHandleCallSlice(t *testing.T) {
+	mapper := New()
+	Register(mapper, personToDTO)
+	toDTO, _ := Handle[Person, *PersonDTO](mapper)
+
+	t.Run("MapsInOrder", func(t *testing.T) {
+		got := toDTO.CallSlice([]Person{{Name: "Ann"}, {Name: "Bob"}})
+		want := []*PersonDTO{{FullName: "Ann"}, {FullName: "Bob"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("NilAndEmpty", func(t *testing.T) {
+		if got := toDTO.CallSlice(nil); got != nil {
+			t.Errorf("Expected nil, got %v", got)
+		}
+		if got := toDTO.CallSlice([]Person{}); got == nil || len(got) != 0 {
+			t.Errorf("Expected empty slice, got %v", got)
+		}
+	})
+}
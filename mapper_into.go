@@ -0,0 +1,125 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jinzhu/copier"
+)
+
+// ErrUnmappedFields is returned by MapInto when AutoMapOptions.FailOnUnmapped is set and
+// the source has fields with no destination field.
+var ErrUnmappedFields = errors.New("source fields have no destination")
+
+// ErrAmbiguousFields is returned by MapInto when AutoMapOptions.FailOnUnmapped is set and
+// several fields of the source or of the destination match the same name, so which of them
+// a value lands in or comes from would be an accident of field order.
+var ErrAmbiguousFields = errors.New("several fields match the same name")
+
+// ErrNilDestination is returned by MapInto when the destination pointer is nil.
+var ErrNilDestination = errors.New("destination must not be nil")
+
+// MapInto copies the fields of src into the existing value pointed to by dst, leaving
+// destination fields without a source counterpart untouched. It suits update semantics,
+// such as applying a request payload onto a loaded model, where Map would reset every
+// field it does not set.
+//
+// Fields are matched like RegisterAutoMap, using the mapper's default AutoMapOptions, and
+// slice fields whose element types differ, and struct fields of differing types, are
+// mapped through the registry. With FailOnUnmapped set, MapInto first checks that every
+// name is matched by at most one field on each side and that every source field has a
+// destination, and otherwise returns an error naming the offending fields without
+// modifying dst. The field correspondence is computed on each call; no registration is
+// needed or consulted for the S -> D pair itself.
+//
+// WithSkipZero gives patch semantics: source fields holding their zero value are not
+// copied, so the destination keeps its current values for them. WithCaseInsensitive
+// matches field names regardless of case. WithDefault has no effect, since dst already
+// holds the base value.
+//
+// Type Parameters:
+//   - S: Source type; a struct or a pointer to a struct
+//   - D: Destination type; a struct or a pointer to a struct
+//
+// Parameters:
+//   - m: The mapper whose AutoMapOptions match the fields
+//   - src: The value to copy from; a nil pointer leaves dst unchanged
+//   - dst: Pointer to the value to copy into
+//   - opts: Options for this call
+//
+// Returns:
+//   - error: ErrNilDestination if dst is nil, an error wrapping ErrAutoMapUnsupported if S
+//     or D is not a struct or a pointer to a struct, or an error wrapping
+//     ErrAmbiguousFields naming the conflicting fields or ErrUnmappedFields naming the
+//     source fields with no destination
+//
+// Example:
+//
+//	mapper := New(WithAutoMapOptions(AutoMapOptions{FailOnUnmapped: true}))
+//
+//	user := loadUser(id)
+//	if err := MapInto(mapper, UpdateUserRequest{Name: "John"}, &user); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	// Only overwrite the fields the request sets
+//	err := MapInto(mapper, UpdateUserRequest{Email: "john@example.com"}, &user, WithSkipZero())
+func MapInto[S any, D any](m Mapper, src S, dst *D, opts ...MapOption) error {
+	if dst == nil {
+		return ErrNilDestination
+	}
+
+	var call mapCall
+	for _, opt := range opts {
+		opt(&call)
+	}
+	return autoMapInto(m, src, dst, call)
+}
+
+// autoMapInto copies src into *dst in place for MapInto. With default matching, types
+// without nested slices or self references are copied by copier straight into dst, which
+// is addressable, so unmatched fields are preserved and skipZero maps to copier's
+// IgnoreEmpty. Everything else is applied with the field plan.
+func autoMapInto[S any, D any](m Mapper, src S, dst *D, call mapCall) (err error) {
+	srcType, dstType := typeOf[S](), typeOf[D]()
+	base := m.config.withGlobalIgnore(m.config.autoMap)
+	opts := base
+	if call.caseInsensitive || !opts.usesPlan() {
+		// Match names like RegisterAutoMap does by default
+		opts.CaseInsensitive = true
+	}
+	plan := newFieldPlan(srcType, dstType, opts)
+	if plan == nil {
+		return fmt.Errorf("%w: %s->%s", ErrAutoMapUnsupported, srcType, dstType)
+	}
+	if opts.FailOnUnmapped && len(plan.ambiguous) > 0 {
+		return fmt.Errorf("%w: %s->%s: %s", ErrAmbiguousFields, srcType, dstType, strings.Join(plan.ambiguous, "; "))
+	}
+	if opts.FailOnUnmapped && len(plan.unmapped) > 0 {
+		return fmt.Errorf("%w: %s->%s: %s", ErrUnmappedFields, srcType, dstType, strings.Join(plan.unmapped, ", "))
+	}
+
+	srcValue := reflect.ValueOf(&src).Elem()
+	if srcValue.Kind() == reflect.Pointer && srcValue.IsNil() {
+		return nil
+	}
+
+	copyDirect := !base.usesPlan() && dstType.Kind() != reflect.Pointer &&
+		!plan.recursive() && len(plan.nestedFields().fields) == 0
+	if !copyDirect {
+		defer recoverFailure(&err)
+		w := &planWalker{m: m, plan: plan, skipZero: call.skipZero}
+		w.run(reflect.ValueOf(dst).Elem(), srcValue)
+		return nil
+	}
+
+	if err := safeCopy(dst, &src, copier.Option{IgnoreEmpty: call.skipZero}); err != nil {
+		if errors.Is(err, ErrCopierPanic) {
+			return err
+		}
+		return fmt.Errorf("copy %s into %s: %w", srcType, dstType, err)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package mapper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestMapInto tests copying fields into an existing destination value
+func TestMapInto(t *testing.T) {
+	type User struct {
+		ID    int
+		Name  string
+		Email string
+	}
+	type UpdateUser struct {
+		Name  string
+		Email string
+	}
+
+	t.Run("KeepsUnmatchedFields", func(t *testing.T) {
+		mapper := New()
+		user := User{ID: 7, Name: "Old", Email: "old@example.com"}
+
+		if err := MapInto(mapper, UpdateUser{Name: "New", Email: "new@example.com"}, &user); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := User{ID: 7, Name: "New", Email: "new@example.com"}
+		if user != want {
+			t.Errorf("Expected %+v, got %+v", want, user)
+		}
+	})
+
+	t.Run("SkipZeroKeepsDestinationValues", func(t *testing.T) {
+		mapper := New()
+		user := User{ID: 7, Name: "Old", Email: "old@example.com"}
+
+		if err := MapInto(mapper, UpdateUser{Email: "new@example.com"}, &user, WithSkipZero()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := User{ID: 7, Name: "Old", Email: "new@example.com"}
+		if user != want {
+			t.Errorf("Expected %+v, got %+v", want, user)
+		}
+	})
+
+	t.Run("WithoutSkipZeroOverwritesWithZero", func(t *testing.T) {
+		mapper := New()
+		user := User{ID: 7, Name: "Old", Email: "old@example.com"}
+
+		if err := MapInto(mapper, &UpdateUser{Email: "new@example.com"}, &user); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := User{ID: 7, Email: "new@example.com"}
+		if user != want {
+			t.Errorf("Expected %+v, got %+v", want, user)
+		}
+	})
+
+	t.Run("SkipZeroWithFieldPlan", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{IgnoreFields: []string{"ID"}}))
+		user := User{ID: 7, Name: "Old", Email: "old@example.com"}
+
+		if err := MapInto(mapper, User{ID: 9, Name: "New"}, &user, WithSkipZero()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := User{ID: 7, Name: "New", Email: "old@example.com"}
+		if user != want {
+			t.Errorf("Expected %+v, got %+v", want, user)
+		}
+	})
+
+	t.Run("NilSourceLeavesDestination", func(t *testing.T) {
+		mapper := New()
+		user := User{ID: 7, Name: "Old"}
+
+		if err := MapInto[*UpdateUser](mapper, nil, &user); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if user.Name != "Old" {
+			t.Errorf("Expected Old, got %s", user.Name)
+		}
+	})
+
+	t.Run("PointerDestination", func(t *testing.T) {
+		mapper := New()
+		var user *User
+
+		if err := MapInto(mapper, UpdateUser{Name: "New"}, &user); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if user == nil || user.Name != "New" {
+			t.Errorf("Expected allocated user named New, got %+v", user)
+		}
+	})
+
+	t.Run("FailOnUnmappedPassesWhenAllFieldsLand", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{FailOnUnmapped: true}))
+		user := User{ID: 7}
+
+		if err := MapInto(mapper, UpdateUser{Name: "New"}, &user); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("FailOnUnmappedReportsDrift", func(t *testing.T) {
+		// UpdateUser gained a field that User does not have
+		type UpdateUserV2 struct {
+			Name     string
+			Email    string
+			Nickname string
+			Avatar   string
+		}
+
+		mapper := NewBuilder().FailOnUnmapped().Build()
+		user := User{ID: 7, Name: "Old"}
+
+		err := MapInto(mapper, UpdateUserV2{Name: "New", Nickname: "nick"}, &user)
+		if !errors.Is(err, ErrUnmappedFields) {
+			t.Fatalf("Expected ErrUnmappedFields, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "Nickname, Avatar") {
+			t.Errorf("Expected error to list Nickname, Avatar, got %v", err)
+		}
+		if user.Name != "Old" {
+			t.Errorf("Expected destination to be left unchanged, got %+v", user)
+		}
+
+		// Without the option the extra fields are dropped
+		if err := MapInto(New(), UpdateUserV2{Name: "New", Nickname: "nick"}, &user); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("IgnoredFieldsAreNotUnmapped", func(t *testing.T) {
+		type Payload struct {
+			Name  string
+			Token string
+		}
+
+		mapper := New(WithAutoMapOptions(AutoMapOptions{FailOnUnmapped: true, IgnoreFields: []string{"Token"}}))
+		var user User
+
+		if err := MapInto(mapper, Payload{Name: "New", Token: "secret"}, &user); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mapper := New()
+
+		if err := MapInto[UpdateUser, User](mapper, UpdateUser{}, nil); !errors.Is(err, ErrNilDestination) {
+			t.Errorf("Expected ErrNilDestination, got %v", err)
+		}
+		var n int
+		if err := MapInto(mapper, "text", &n); !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported, got %v", err)
+		}
+	})
+}
+
+// TestMapIntoAmbiguousFields tests rejecting names matched by several fields
+func TestMapIntoAmbiguousFields(t *testing.T) {
+	type Payload struct {
+		Key  int `json:"id"`
+		Name string
+	}
+	type Record struct {
+		ID   int
+		Id   int
+		Name string
+	}
+
+	strict := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true, CaseInsensitive: true, FailOnUnmapped: true}))
+
+	t.Run("DestinationFieldsCaseVariants", func(t *testing.T) {
+		record := Record{ID: 1, Id: 2}
+
+		err := MapInto(strict, Payload{Key: 7, Name: "New"}, &record)
+		if !errors.Is(err, ErrAmbiguousFields) {
+			t.Fatalf("Expected ErrAmbiguousFields, got %v", err)
+		}
+		if !strings.Contains(err.Error(), `destination fields ID, Id all match "id"`) {
+			t.Errorf("Expected error to name ID and Id, got %v", err)
+		}
+		if record != (Record{ID: 1, Id: 2}) {
+			t.Errorf("Expected destination to be left unchanged, got %+v", record)
+		}
+	})
+
+	t.Run("SourceFieldsCaseVariants", func(t *testing.T) {
+		var payload Payload
+		err := MapInto(strict, Record{ID: 1, Id: 2}, &payload)
+		if !errors.Is(err, ErrAmbiguousFields) || !strings.Contains(err.Error(), "source fields ID, Id") {
+			t.Errorf("Expected ErrAmbiguousFields naming the source fields, got %v", err)
+		}
+	})
+
+	t.Run("ExactMatchingIsNotAmbiguous", func(t *testing.T) {
+		exact := New(WithAutoMapOptions(AutoMapOptions{FailOnUnmapped: true, IgnoreFields: []string{"Key"}}))
+		record := Record{ID: 1, Id: 2}
+
+		if err := MapInto(exact, Payload{Name: "New"}, &record); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("LenientWithoutFailOnUnmapped", func(t *testing.T) {
+		lenient := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true, CaseInsensitive: true}))
+		var record Record
+
+		if err := MapInto(lenient, Payload{Key: 7}, &record); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package mapper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ErrNotJSONArray is returned, wrapped, by MapJSONStream when the input does not start with
+// a JSON array.
+var ErrNotJSONArray = errors.New("JSON input is not an array")
+
+// MapJSONStream decodes a JSON array from r one element at a time, maps each element to D
+// and hands it to sink. Only one element is held in memory at a time, which suits importing
+// large exports in low-memory ETL jobs where decoding the whole array first would not fit.
+//
+// Each element is unmarshaled into a new value of srcType, then mapped like Map[any, D],
+// so conditional mappings, post-processors and the fallback apply as usual. A pointer
+// srcType decodes into a newly allocated value and maps it as a pointer; a JSON null
+// element then maps like a nil pointer source.
+//
+// Processing stops at the first failure, after the elements before it were delivered.
+// Errors from decoding, mapping and sink are wrapped with the index of the element, such
+// as "element 3: ...", so errors.Is still matches ErrNoMapping or the sink's own errors.
+// Malformed JSON cannot be resumed, so the decoder's syntax error ends the stream.
+//
+// Type Parameters:
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - r: The reader providing the JSON array
+//   - srcType: The type each element is unmarshaled into; it must not be nil
+//   - sink: The function receiving each mapped element, in input order
+//
+// Returns:
+//   - error: nil once the whole array is processed, an error wrapping ErrNotJSONArray if
+//     the input does not start with an array, or the first element error
+//
+// Example:
+//
+//	f, _ := os.Open("users.json")
+//	defer f.Close()
+//
+//	err := MapJSONStream(mapper, f, reflect.TypeOf(UserRecord{}), func(u User) error {
+//	    return store.Insert(u)
+//	})
+func MapJSONStream[D any](m Mapper, r io.Reader, srcType reflect.Type, sink func(D) error) error {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrNotJSONArray, err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("%w: starts with %v", ErrNotJSONArray, tok)
+	}
+
+	for i := 0; dec.More(); i++ {
+		src := reflect.New(srcType)
+		if err := dec.Decode(src.Interface()); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+		dst, err := Map[any, D](m, src.Elem().Interface())
+		if err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+		if err := sink(dst); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	// Consume the closing bracket so a truncated array is reported
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("end of array: %w", err)
+	}
+	return nil
+}
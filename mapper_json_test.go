@@ -0,0 +1,117 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestMapJSONStream tests mapping the elements of a JSON array as they are decoded
+func TestMapJSONStream(t *testing.T) {
+	personType := reflect.TypeOf(Person{})
+
+	collect := func(got *[]PersonDTO) func(PersonDTO) error {
+		return func(dto PersonDTO) error {
+			*got = append(*got, dto)
+			return nil
+		}
+	}
+
+	t.Run("MapsEachElement", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		var got []PersonDTO
+		input := `[{"Name": "Ann", "Age": 30}, {"Name": "Bo", "Age": 40}]`
+		if err := MapJSONStream(mapper, strings.NewReader(input), personType, collect(&got)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []PersonDTO{{FullName: "Ann", Years: 30}, {FullName: "Bo", Years: 40}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("PointerSourceType", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterNilDefault[Person](mapper, PersonDTO{FullName: "unknown"})
+
+		var got []PersonDTO
+		input := `[{"Name": "Ann"}, null]`
+		if err := MapJSONStream(mapper, strings.NewReader(input), reflect.TypeOf(&Person{}), collect(&got)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []PersonDTO{{FullName: "Ann"}, {FullName: "unknown"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("FailingElementReportsIndex", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		var got []PersonDTO
+		input := `[{"Name": "Ann"}, {"Name": 5}, {"Name": "Cy"}]`
+		err := MapJSONStream(mapper, strings.NewReader(input), personType, collect(&got))
+		if err == nil || !strings.HasPrefix(err.Error(), "element 1: ") {
+			t.Errorf("Expected an error for element 1, got %v", err)
+		}
+		if len(got) != 1 {
+			t.Errorf("Expected the element before the failure to be delivered, got %v", got)
+		}
+	})
+
+	t.Run("MalformedJSON", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		var got []PersonDTO
+		err := MapJSONStream(mapper, strings.NewReader(`[{"Name": "Ann"}, {"Name": }]`), personType, collect(&got))
+		if err == nil || !strings.HasPrefix(err.Error(), "element 1: ") {
+			t.Errorf("Expected a syntax error for element 1, got %v", err)
+		}
+
+		err = MapJSONStream(mapper, strings.NewReader(`[{"Name": "Ann"}`), personType, collect(&got))
+		if err == nil {
+			t.Error("Expected an error for a truncated array")
+		}
+	})
+
+	t.Run("MappingAndSinkErrors", func(t *testing.T) {
+		mapper := New()
+
+		err := MapJSONStream(mapper, strings.NewReader(`[{}]`), personType, func(PersonDTO) error { return nil })
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+
+		Register(mapper, personToDTO)
+		errStop := errors.New("stop")
+		calls := 0
+		err = MapJSONStream(mapper, strings.NewReader(`[{}, {}, {}]`), personType, func(PersonDTO) error {
+			calls++
+			if calls == 2 {
+				return errStop
+			}
+			return nil
+		})
+		if !errors.Is(err, errStop) || !strings.HasPrefix(err.Error(), "element 1: ") || calls != 2 {
+			t.Errorf("Expected the sink error at element 1 after 2 calls, got %v after %d calls", err, calls)
+		}
+	})
+
+	t.Run("NotAnArray", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		for _, input := range []string{`{"Name": "Ann"}`, ``, `"text"`} {
+			err := MapJSONStream(mapper, strings.NewReader(input), personType, func(PersonDTO) error { return nil })
+			if !errors.Is(err, ErrNotJSONArray) {
+				t.Errorf("Expected ErrNotJSONArray for %q, got %v", input, err)
+			}
+		}
+	})
+}
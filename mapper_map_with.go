@@ -0,0 +1,168 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrMapOptionType is returned by MapWith when the value given to WithDefault is not of
+// the destination type or a pointer to it.
+var ErrMapOptionType = errors.New("map option value does not match the destination type")
+
+// MapOption adjusts a single MapWith or MapInto call.
+type MapOption func(*mapCall)
+
+// mapCall holds the per-call settings collected from MapOptions.
+type mapCall struct {
+	def             reflect.Value
+	skipZero        bool
+	caseInsensitive bool
+}
+
+// autoMaps reports whether the call asks for auto-mapping behavior.
+func (c mapCall) autoMaps() bool {
+	return c.skipZero || c.caseInsensitive
+}
+
+// WithDefault makes a MapWith call return def for a nil pointer source, whatever mapping
+// is registered. When the call is auto-mapped, def is also the value the source fields are
+// copied onto. It takes precedence over a default set with RegisterNilDefault.
+//
+// Type Parameters:
+//   - D: The destination type of the call, or the type it points to
+//
+// Parameters:
+//   - def: The default destination value
+//
+// Returns:
+//   - MapOption: The option to pass to MapWith
+func WithDefault[D any](def D) MapOption {
+	value := reflect.ValueOf(&def).Elem()
+	return func(c *mapCall) {
+		c.def = value
+	}
+}
+
+// WithSkipZero makes an auto-mapped MapWith call skip source fields that hold their zero
+// value, so the corresponding destination fields keep the value given with WithDefault.
+// With MapInto, they keep the value already in the destination.
+//
+// Returns:
+//   - MapOption: The option to pass to MapWith
+func WithSkipZero() MapOption {
+	return func(c *mapCall) {
+		c.skipZero = true
+	}
+}
+
+// WithCaseInsensitive makes an auto-mapped MapWith call match field names regardless of
+// case, like AutoMapOptions.CaseInsensitive.
+//
+// Returns:
+//   - MapOption: The option to pass to MapWith
+func WithCaseInsensitive() MapOption {
+	return func(c *mapCall) {
+		c.caseInsensitive = true
+	}
+}
+
+// MapWith maps src to D like Map, with options adjusting this call only. It covers one-off
+// needs, such as a default for a missing value or a lenient auto-mapping in a script,
+// without a separate registration.
+//
+// Which options apply depends on how the pair is mapped:
+//   - WithDefault applies to every call: a nil pointer source maps to the default without
+//     calling any mapping function.
+//   - WithSkipZero and WithCaseInsensitive configure auto-mapping. They are no-ops when the
+//     pair has a registered mapping, whether manual or from RegisterAutoMap, which is used
+//     exactly as Map would. When the pair has no registered mapping, they make MapWith
+//     auto-map the call with the mapper's AutoMapOptions adjusted by the options, copying
+//     onto the WithDefault value if one is given.
+//
+// Without options, MapWith is equivalent to Map.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source value to be mapped
+//   - opts: Options for this call
+//
+// Returns:
+//   - D: The mapped destination value
+//   - error: Any error Map would return, an error wrapping ErrMapOptionType if the
+//     WithDefault value does not match D, or an error wrapping ErrAutoMapUnsupported if
+//     the call is auto-mapped and S or D is not a struct or a pointer to a struct
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Person) PersonDTO { return PersonDTO{Name: p.Name} })
+//
+//	var author *Person
+//	dto, _ := MapWith[*Person, PersonDTO](mapper, author, WithDefault(PersonDTO{Name: "unknown"}))
+//	fmt.Println(dto.Name) // Output: unknown
+func MapWith[S any, D any](m Mapper, src S, opts ...MapOption) (_ D, err error) {
+	if len(opts) == 0 {
+		return Map[S, D](m, src)
+	}
+
+	var dst D
+	var call mapCall
+	for _, opt := range opts {
+		opt(&call)
+	}
+
+	srcValue := reflect.ValueOf(&src).Elem()
+	dstType := typeOf[D]()
+	if reflect.TypeOf(src) == nil {
+		return dst, fmt.Errorf("%w to %s", ErrUntypedNil, dstType)
+	}
+	if call.def.IsValid() {
+		if derefType(call.def.Type()) != derefType(dstType) {
+			return dst, fmt.Errorf("%w: default is %s, destination is %s", ErrMapOptionType, call.def.Type(), dstType)
+		}
+		if srcValue.Kind() == reflect.Pointer && srcValue.IsNil() {
+			return valueAs[D](adaptResult(call.def, dstType)), nil
+		}
+	}
+
+	if !call.autoMaps() || hasMapping(m, pairOf(reflect.TypeOf(src), dstType)) {
+		return Map[S, D](m, src)
+	}
+
+	autoOpts := m.config.withGlobalIgnore(m.config.autoMap)
+	if call.caseInsensitive || !autoOpts.usesPlan() {
+		autoOpts.CaseInsensitive = true
+	}
+	plan := newFieldPlan(typeOf[S](), dstType, autoOpts)
+	if plan == nil {
+		return dst, fmt.Errorf("%w: %s->%s", ErrAutoMapUnsupported, typeOf[S](), dstType)
+	}
+
+	if call.def.IsValid() {
+		base := adaptResult(call.def, dstType)
+		if base.Kind() == reflect.Pointer && !base.IsNil() {
+			// Copy onto a fresh pointer so the caller's default is not modified
+			fresh := reflect.New(base.Type().Elem())
+			fresh.Elem().Set(base.Elem())
+			base = fresh
+		}
+		dst = valueAs[D](base)
+	}
+	defer recoverFailure(&err)
+	w := &planWalker{m: m, plan: plan, skipZero: call.skipZero}
+	w.run(reflect.ValueOf(&dst).Elem(), srcValue)
+	return dst, nil
+}
+
+// hasMapping reports whether key has a registered or conditional mapping.
+func hasMapping(m Mapper, key typePair) bool {
+	if _, ok := m.registry.get(key); ok {
+		return true
+	}
+	return len(m.config.conditions[key]) > 0
+}
@@ -0,0 +1,135 @@
+package mapper
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestMapWith tests per-call options for a single mapping
+func TestMapWith(t *testing.T) {
+	unknown := PersonDTO{FullName: "unknown"}
+
+	t.Run("DefaultForNilPointerSource", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapWith[*Person, PersonDTO](mapper, nil, WithDefault(unknown))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != unknown {
+			t.Errorf("Expected %+v, got %+v", unknown, got)
+		}
+
+		ptr, err := MapWith[*Person, *PersonDTO](mapper, nil, WithDefault(unknown))
+		if err != nil || ptr == nil || *ptr != unknown {
+			t.Errorf("Expected pointer to %+v, got %+v (err: %v)", unknown, ptr, err)
+		}
+	})
+
+	t.Run("DefaultIgnoredForNonNilSource", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapWith[*Person, PersonDTO](mapper, &Person{Name: "Alice", Age: 20}, WithDefault(unknown))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != (PersonDTO{"Alice", 20}) {
+			t.Errorf("Expected {Alice 20}, got %+v", got)
+		}
+	})
+
+	t.Run("DefaultOverridesNilDefault", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterNilDefault[Person](mapper, PersonDTO{FullName: "registered"})
+
+		got, err := MapWith[*Person, PersonDTO](mapper, nil, WithDefault(unknown))
+		if err != nil || got != unknown {
+			t.Errorf("Expected %+v, got %+v (err: %v)", unknown, got, err)
+		}
+	})
+
+	t.Run("DefaultOfWrongType", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		_, err := MapWith[*Person, PersonDTO](mapper, nil, WithDefault("unknown"))
+		if !errors.Is(err, ErrMapOptionType) {
+			t.Errorf("Expected ErrMapOptionType, got %v", err)
+		}
+	})
+
+	t.Run("NoOptionsIsMap", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapWith[Person, PersonDTO](mapper, Person{})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("AutoMapsUnregisteredPair", func(t *testing.T) {
+		type Source struct {
+			NAME  string
+			Email string
+		}
+		type Dest struct {
+			Name   string
+			Email  string
+			Status string
+		}
+
+		mapper := New()
+		got, err := MapWith[Source, Dest](mapper, Source{NAME: "Alice"},
+			WithCaseInsensitive(),
+			WithSkipZero(),
+			WithDefault(Dest{Email: "none", Status: "active"}),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := Dest{Name: "Alice", Email: "none", Status: "active"}
+		if got != want {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	})
+
+	t.Run("AutoMapOptionsIgnoredForRegisteredPair", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapWith[Person, PersonDTO](mapper, Person{Name: "Alice"}, WithSkipZero(), WithDefault(unknown))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.FullName != "Alice" || got.Years != 0 {
+			t.Errorf("Expected the registered mapping result, got %+v", got)
+		}
+	})
+
+	t.Run("PointerDefaultIsNotModified", func(t *testing.T) {
+		type Source struct{ Name string }
+		type Dest struct{ Name, Status string }
+
+		def := &Dest{Status: "active"}
+		got, err := MapWith[Source, *Dest](New(), Source{Name: "Alice"}, WithSkipZero(), WithDefault(def))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got == def || got.Name != "Alice" || got.Status != "active" {
+			t.Errorf("Expected a copy of the default with Name set, got %+v", got)
+		}
+		if def.Name != "" {
+			t.Errorf("Expected the default to be left unchanged, got %+v", def)
+		}
+	})
+
+	t.Run("AutoMapUnsupportedTypes", func(t *testing.T) {
+		_, err := MapWith[string, int](New(), "text", WithCaseInsensitive())
+		if !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package mapper
+
+// MapMapValues maps every value of a map through the registered V -> V2 mapping function,
+// keeping the keys unchanged. The mapping function is resolved once before iterating.
+//
+// Type Parameters:
+//   - K: Key type shared by the source and destination maps
+//   - V: Source value type
+//   - V2: Destination value type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source map whose values are mapped
+//
+// Returns:
+//   - map[K]V2: A new map with the same keys and mapped values (nil if src is nil)
+//   - error: ErrNoMapping if no mapping function is registered for V -> V2
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(u User) UserDTO { return UserDTO{Name: u.Name} })
+//
+//	users := map[UserID]User{1: {Name: "John"}}
+//	dtos, err := MapMapValues[UserID, User, UserDTO](mapper, users)
+//	fmt.Println(dtos[1].Name) // Output: John
+func MapMapValues[K comparable, V any, V2 any](m Mapper, src map[K]V) (map[K]V2, error) {
+	fn, ok := lookup[V, V2](m)
+	if !ok {
+		return nil, ErrNoMapping
+	}
+	if src == nil {
+		return nil, nil
+	}
+
+	dst := make(map[K]V2, len(src))
+	for k, v := range src {
+		mapped, err := callMapping[V, V2](fn, v)
+		if err != nil {
+			return nil, err
+		}
+		mapped = postProcess(m, v, mapped)
+		dst[k] = mapped
+	}
+	return dst, nil
+}
+
+// MapMapKeys maps every key of a map through the registered K -> K2 mapping function,
+// keeping the values unchanged. The mapping function is resolved once before iterating.
+//
+// Key collisions follow a last-write-wins rule: when several source keys map to the same
+// destination key, the entry written last is kept and the others are discarded. Because
+// Go map iteration order is unspecified, which of the colliding values survives is
+// unspecified as well; use an injective key mapping when that matters.
+//
+// Type Parameters:
+//   - K: Source key type
+//   - K2: Destination key type
+//   - V: Value type shared by the source and destination maps
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source map whose keys are mapped
+//
+// Returns:
+//   - map[K2]V: A new map with mapped keys and the original values (nil if src is nil)
+//   - error: ErrNoMapping if no mapping function is registered for K -> K2
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(id UserID) string { return fmt.Sprintf("user-%d", id) })
+//
+//	data := map[UserID]Data{1: {Value: "a"}}
+//	byName, err := MapMapKeys[UserID, string, Data](mapper, data)
+//	fmt.Println(byName["user-1"].Value) // Output: a
+func MapMapKeys[K comparable, K2 comparable, V any](m Mapper, src map[K]V) (map[K2]V, error) {
+	fn, ok := lookup[K, K2](m)
+	if !ok {
+		return nil, ErrNoMapping
+	}
+	if src == nil {
+		return nil, nil
+	}
+
+	dst := make(map[K2]V, len(src))
+	for k, v := range src {
+		mapped, err := callMapping[K, K2](fn, k)
+		if err != nil {
+			return nil, err
+		}
+		mapped = postProcess(m, k, mapped)
+		dst[mapped] = v
+	}
+	return dst, nil
+}
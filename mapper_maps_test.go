@@ -0,0 +1,132 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestMapMapValues tests mapping the values of a map
+func TestMapMapValues(t *testing.T) {
+	t.Run("MapsEveryValue", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		src := map[string]Person{
+			"a": {Name: "Alice", Age: 20},
+			"b": {Name: "Bob", Age: 30},
+		}
+		got, err := MapMapValues[string, Person, PersonDTO](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := map[string]PersonDTO{
+			"a": {FullName: "Alice", Years: 20},
+			"b": {FullName: "Bob", Years: 30},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("PointerValues", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		src := map[int]*Person{1: {Name: "Alice", Age: 20}, 2: nil}
+		got, err := MapMapValues[int, *Person, *PersonDTO](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got[1] == nil || got[1].FullName != "Alice" {
+			t.Errorf("Expected pointer to {Alice 20}, got %v", got[1])
+		}
+		if v, ok := got[2]; !ok || v != nil {
+			t.Errorf("Expected nil value for key 2, got %v (present: %v)", v, ok)
+		}
+	})
+
+	t.Run("NilMapReturnsNil", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapMapValues[string, Person, PersonDTO](mapper, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != nil {
+			t.Errorf("Expected nil map, got %v", got)
+		}
+	})
+
+	t.Run("UnregisteredReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapMapValues[string, Person, PersonDTO](mapper, map[string]Person{})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+}
+
+// TestMapMapKeys tests mapping the keys of a map
+func TestMapMapKeys(t *testing.T) {
+	type UserID int
+
+	t.Run("MapsEveryKey", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(id UserID) string { return intToString(int(id)) })
+
+		src := map[UserID]Person{1: {Name: "Alice"}, 2: {Name: "Bob"}}
+		got, err := MapMapKeys[UserID, string, Person](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := map[string]Person{
+			"number-1": {Name: "Alice"},
+			"number-2": {Name: "Bob"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("CollidingKeysKeepOneEntry", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(id UserID) string { return "same" })
+
+		src := map[UserID]int{1: 10, 2: 20}
+		got, err := MapMapKeys[UserID, string, int](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("Expected 1 entry after collision, got %d", len(got))
+		}
+		if v := got["same"]; v != 10 && v != 20 {
+			t.Errorf("Expected one of the source values, got %d", v)
+		}
+	})
+
+	t.Run("NilMapReturnsNil", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(id UserID) string { return "" })
+
+		got, err := MapMapKeys[UserID, string, int](mapper, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != nil {
+			t.Errorf("Expected nil map, got %v", got)
+		}
+	})
+
+	t.Run("UnregisteredReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapMapKeys[UserID, string, int](mapper, map[UserID]int{1: 1})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+}
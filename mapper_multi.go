@@ -0,0 +1,65 @@
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// RegisterMulti registers one mapping function to D for each of the given source types. It
+// suits groups of related types, such as versions of an enum, that all map to the same
+// destination through the same logic.
+//
+// fn receives the source boxed in an any, so it must recover the concrete type itself,
+// typically with a type switch. Each source type is keyed like Register keys S, with one
+// level of pointer indirection stripped, and a pointer source is dereferenced before it is
+// boxed: fn always sees the value form, and a nil pointer source maps to the zero value of D
+// without calling fn. Because the function is not of type func(S) D, calls go through the
+// reflection path of Map, which costs more than a function registered with Register.
+// Registering under a type that already has a mapping to D replaces it.
+//
+// Type Parameters:
+//   - D: Destination type (output type for the mapping function)
+//
+// Parameters:
+//   - m: The mapper instance to register the function with
+//   - fn: The mapping function, called with the source boxed in an any
+//   - srcTypes: The source types to register fn under
+//
+// Panics:
+//   - If fn is nil, with an error wrapping ErrNilMappingFunc
+//   - If one of srcTypes is nil, with an error wrapping ErrInvalidMappingFunc; nothing is
+//     registered in that case
+//
+// Example:
+//
+//	mapper := New()
+//	RegisterMulti(mapper, func(src any) StatusDTO {
+//	    switch s := src.(type) {
+//	    case StatusV1:
+//	        return StatusDTO{Code: int(s)}
+//	    case StatusV2:
+//	        return StatusDTO{Code: s.Code}
+//	    }
+//	    return StatusDTO{}
+//	}, reflect.TypeFor[StatusV1](), reflect.TypeFor[StatusV2]())
+//
+//	dto, _ := Map[StatusV2, StatusDTO](mapper, StatusV2{Code: 2})
+func RegisterMulti[D any](m Mapper, fn func(any) D, srcTypes ...reflect.Type) {
+	dstType := typeOf[D]()
+	if fn == nil {
+		panic(nilFuncError(typePair{src: typeOf[any](), dst: dstType}))
+	}
+	for i, srcType := range srcTypes {
+		if srcType == nil {
+			panic(fmt.Errorf("%w: nil source type at index %d for %s", ErrInvalidMappingFunc, i, dstType))
+		}
+	}
+
+	m.registry.update(func(entries map[typePair]any, kinds map[typePair]entryKind) {
+		for _, srcType := range srcTypes {
+			key := pairOf(srcType, dstType)
+			entries[key] = fn
+			delete(kinds, key)
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type statusV1 int
+
+type statusV2 struct {
+	Code  int
+	Label string
+}
+
+type statusDTO struct {
+	Code  int
+	Label string
+}
+
+func statusToDTO(src any) statusDTO {
+	switch s := src.(type) {
+	case statusV1:
+		return statusDTO{Code: int(s), Label: "v1"}
+	case statusV2:
+		return statusDTO{Code: s.Code, Label: s.Label}
+	}
+	return statusDTO{}
+}
+
+// TestRegisterMulti tests registering one boxed mapping function under several source types
+func TestRegisterMulti(t *testing.T) {
+	t.Run("MapsEachSourceType", func(t *testing.T) {
+		mapper := New()
+		RegisterMulti(mapper, statusToDTO, reflect.TypeFor[statusV1](), reflect.TypeFor[statusV2]())
+
+		v1, err := Map[statusV1, statusDTO](mapper, 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := (statusDTO{Code: 3, Label: "v1"}); v1 != want {
+			t.Errorf("Expected %v, got %v", want, v1)
+		}
+
+		v2, err := Map[statusV2, statusDTO](mapper, statusV2{Code: 7, Label: "active"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := (statusDTO{Code: 7, Label: "active"}); v2 != want {
+			t.Errorf("Expected %v, got %v", want, v2)
+		}
+	})
+
+	t.Run("PointerSourcesAreDereferenced", func(t *testing.T) {
+		mapper := New()
+		RegisterMulti(mapper, statusToDTO, reflect.TypeFor[*statusV2]())
+
+		dto, err := Map[*statusV2, *statusDTO](mapper, &statusV2{Code: 1, Label: "ptr"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto == nil || dto.Label != "ptr" {
+			t.Errorf("Expected label ptr, got %v", dto)
+		}
+
+		dto, err = Map[*statusV2, *statusDTO](mapper, nil)
+		if err != nil || dto != nil {
+			t.Errorf("Expected nil result for nil source, got %v, %v", dto, err)
+		}
+	})
+
+	t.Run("UnlistedTypeIsNotRegistered", func(t *testing.T) {
+		mapper := New()
+		RegisterMulti(mapper, statusToDTO, reflect.TypeFor[statusV1]())
+
+		if _, err := Map[statusV2, statusDTO](mapper, statusV2{}); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("PassesValidate", func(t *testing.T) {
+		mapper := New()
+		RegisterMulti(mapper, statusToDTO, reflect.TypeFor[statusV1](), reflect.TypeFor[statusV2]())
+
+		if errs := Validate(mapper); errs != nil {
+			t.Errorf("Expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("NilFunctionPanics", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			if err, ok := r.(error); !ok || !errors.Is(err, ErrNilMappingFunc) {
+				t.Errorf("Expected ErrNilMappingFunc panic, got %v", r)
+			}
+		}()
+		RegisterMulti[statusDTO](New(), nil, reflect.TypeFor[statusV1]())
+	})
+
+	t.Run("NilSourceTypePanics", func(t *testing.T) {
+		mapper := New()
+		defer func() {
+			r := recover()
+			if err, ok := r.(error); !ok || !errors.Is(err, ErrInvalidMappingFunc) {
+				t.Errorf("Expected ErrInvalidMappingFunc panic, got %v", r)
+			}
+			if Has[statusV1, statusDTO](mapper) {
+				t.Error("Expected nothing to be registered")
+			}
+		}()
+		RegisterMulti(mapper, statusToDTO, reflect.TypeFor[statusV1](), nil)
+	})
+}
@@ -0,0 +1,65 @@
+package mapper
+
+import (
+	"reflect"
+)
+
+// RegisterNilDefault sets the value that nil pointer sources map to for the S -> D type
+// pair, instead of the zero value. It suits destinations where an empty value is
+// misleading, such as showing an "unknown" author rather than a blank one.
+//
+// The default is used by Map, MapChan and the slice helpers built on MapSlice for nil
+// pointer elements, whatever pointer form the destination takes: a pointer destination
+// receives a pointer to a fresh copy of def rather than nil, or def itself if D is a
+// pointer type. The mapping function itself is still required and is never called with a
+// nil source. Like Register, S and D are keyed with one level of pointer indirection
+// stripped. Remove clears the default together with the mapping.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance to set the default on
+//   - def: The value nil pointer sources map to
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Person) PersonDTO { return PersonDTO{Name: p.Name} })
+//	RegisterNilDefault[Person](mapper, PersonDTO{Name: "unknown"})
+//
+//	dto, _ := Map[*Person, PersonDTO](mapper, nil)
+//	fmt.Println(dto.Name) // Output: unknown
+func RegisterNilDefault[S any, D any](m Mapper, def D) {
+	if m.config.nilDefaults == nil {
+		m.config.nilDefaults = make(map[typePair]reflect.Value)
+	}
+	m.config.nilDefaults[keyOf[S, D]()] = reflect.ValueOf(&def).Elem()
+}
+
+// nilDefaultFor returns the default registered for key if src is a nil pointer. The
+// dynamic type of src is checked, so a nil pointer passed as an interface S counts too.
+func nilDefaultFor[S any, D any](m Mapper, key typePair, src S) (D, bool) {
+	var dst D
+	if len(m.config.nilDefaults) == 0 {
+		return dst, false
+	}
+	def, ok := m.config.nilDefaults[key]
+	if !ok {
+		return dst, false
+	}
+	if v := reflect.ValueOf(src); v.Kind() != reflect.Ptr || !v.IsNil() {
+		return dst, false
+	}
+	return valueAs[D](nilResult(def, typeOf[D]())), true
+}
+
+// nilResult returns the value a nil pointer source maps to for dstType: def adapted to
+// dstType if it is valid, and the zero value otherwise.
+func nilResult(def reflect.Value, dstType reflect.Type) reflect.Value {
+	if !def.IsValid() {
+		return reflect.Zero(dstType)
+	}
+	return adaptResult(def, dstType)
+}
@@ -0,0 +1,102 @@
+package mapper
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestRegisterNilDefault tests mapping nil pointer sources to a registered default
+func TestRegisterNilDefault(t *testing.T) {
+	unknown := PersonDTO{FullName: "unknown"}
+
+	newMapper := func() Mapper {
+		mapper := New()
+		Register(mapper, personToDTO)
+		RegisterNilDefault[Person](mapper, unknown)
+		return mapper
+	}
+
+	t.Run("MapNilToValue", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[*Person, PersonDTO](mapper, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != unknown {
+			t.Errorf("Expected %+v, got %+v", unknown, got)
+		}
+	})
+
+	t.Run("MapNilToPointer", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[*Person, *PersonDTO](mapper, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got == nil || *got != unknown {
+			t.Fatalf("Expected pointer to %+v, got %+v", unknown, got)
+		}
+
+		got.FullName = "changed"
+		again, _ := Map[*Person, *PersonDTO](mapper, nil)
+		if again.FullName != "unknown" {
+			t.Errorf("Expected a fresh copy of the default, got %+v", again)
+		}
+	})
+
+	t.Run("NonNilSourceUsesMapping", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[*Person, PersonDTO](mapper, &Person{Name: "Alice", Age: 20})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.FullName != "Alice" {
+			t.Errorf("Expected Alice, got %+v", got)
+		}
+	})
+
+	t.Run("MapSliceNilElements", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := MapSlice[[]*Person, []PersonDTO](mapper, []*Person{{Name: "Alice", Age: 20}, nil})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []PersonDTO{{"Alice", 20}, unknown}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+
+		ptrs, err := MapSlice[[]*Person, []*PersonDTO](mapper, []*Person{nil})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(ptrs) != 1 || ptrs[0] == nil || *ptrs[0] != unknown {
+			t.Errorf("Expected [&%+v], got %v", unknown, ptrs)
+		}
+	})
+
+	t.Run("WithoutDefaultNilMapsToZero", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := Map[*Person, PersonDTO](mapper, nil)
+		if err != nil || got != (PersonDTO{}) {
+			t.Errorf("Expected zero value, got %+v (err: %v)", got, err)
+		}
+	})
+
+	t.Run("RemoveClearsDefault", func(t *testing.T) {
+		mapper := newMapper()
+		Remove[Person, PersonDTO](mapper)
+		Register(mapper, personToDTO)
+
+		got, err := Map[*Person, PersonDTO](mapper, nil)
+		if err != nil || got != (PersonDTO{}) {
+			t.Errorf("Expected zero value, got %+v (err: %v)", got, err)
+		}
+	})
+}
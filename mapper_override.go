@@ -0,0 +1,56 @@
+package mapper
+
+// WithOverride installs fn as the S -> D mapping, runs body, and then restores the mapping
+// that was registered before, or removes fn if there was none. The previous mapping is
+// restored even if body panics, which makes WithOverride suitable for scoped behavior
+// changes in tests and feature-flag experiments without manual save and restore.
+//
+// The override is not goroutine-isolated: it replaces the mapping in the shared registry,
+// so every goroutine using m observes fn while body runs. Concurrent Map calls are safe,
+// but they may see either mapping around the start and end of body.
+// Conditional mappings registered with RegisterWhen still take precedence over fn.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance whose mapping is overridden
+//   - fn: The mapping function used while body runs
+//   - body: The function to run with the override in place
+//
+// Panics:
+//   - If fn is nil, with an error wrapping ErrNilMappingFunc
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Price) PriceDTO { return PriceDTO{Amount: p.Amount} })
+//
+//	WithOverride(mapper, func(p Price) PriceDTO { return PriceDTO{Amount: p.Amount * 2} }, func() {
+//	    dto, _ := Map[Price, PriceDTO](mapper, Price{Amount: 10})
+//	    fmt.Println(dto.Amount) // Output: 20
+//	})
+//
+//	dto, _ := Map[Price, PriceDTO](mapper, Price{Amount: 10})
+//	fmt.Println(dto.Amount) // Output: 10
+func WithOverride[S any, D any](m Mapper, fn func(S) D, body func()) {
+	key := keyOf[S, D]()
+	state := m.registry.state.Load()
+	previous, existed := state.entries[key]
+	kind := state.kinds[key]
+
+	Register(m, fn)
+	defer func() {
+		if existed {
+			m.registry.update(func(entries map[typePair]any, kinds map[typePair]entryKind) {
+				entries[key] = previous
+				setKind(kinds, key, kind)
+			})
+		} else {
+			m.registry.delete(key)
+		}
+	}()
+
+	body()
+}
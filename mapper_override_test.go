@@ -0,0 +1,88 @@
+package mapper
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestWithOverride tests temporarily replacing a mapping within a scope
+func TestWithOverride(t *testing.T) {
+	double := func(s string) int { return len(s) * 2 }
+
+	t.Run("OverridesAndRestores", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		WithOverride(mapper, double, func() {
+			result, err := Map[string, int](mapper, "hello")
+			if err != nil || result != 10 {
+				t.Errorf("Expected 10 inside override, got %d (err: %v)", result, err)
+			}
+		})
+
+		result, err := Map[string, int](mapper, "hello")
+		if err != nil || result != 5 {
+			t.Errorf("Expected 5 after override, got %d (err: %v)", result, err)
+		}
+	})
+
+	t.Run("RemovesWhenNothingWasRegistered", func(t *testing.T) {
+		mapper := New()
+
+		WithOverride(mapper, double, func() {
+			if !Has[string, int](mapper) {
+				t.Error("Expected override to be registered inside body")
+			}
+		})
+
+		if Has[string, int](mapper) {
+			t.Error("Expected override to be removed after body")
+		}
+		_, err := Map[string, int](mapper, "hello")
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("RestoresOnPanic", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		func() {
+			defer func() {
+				if r := recover(); r != "boom" {
+					t.Errorf("Expected panic to propagate, got %v", r)
+				}
+			}()
+			WithOverride(mapper, double, func() {
+				panic("boom")
+			})
+		}()
+
+		result, err := Map[string, int](mapper, "hello")
+		if err != nil || result != 5 {
+			t.Errorf("Expected original mapping to be restored, got %d (err: %v)", result, err)
+		}
+	})
+
+	t.Run("NestedOverrides", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+		triple := func(s string) int { return len(s) * 3 }
+
+		WithOverride(mapper, double, func() {
+			WithOverride(mapper, triple, func() {
+				if result := MustMap[string, int](mapper, "ab"); result != 6 {
+					t.Errorf("Expected 6 in inner override, got %d", result)
+				}
+			})
+			if result := MustMap[string, int](mapper, "ab"); result != 4 {
+				t.Errorf("Expected 4 after inner override, got %d", result)
+			}
+		})
+
+		if result := MustMap[string, int](mapper, "ab"); result != 2 {
+			t.Errorf("Expected 2 after outer override, got %d", result)
+		}
+	})
+}
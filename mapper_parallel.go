@@ -0,0 +1,280 @@
+package mapper
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelChunkSize is the number of elements a MapSliceParallelCtx worker maps between
+// two checks of the context. It bounds how much work continues after cancellation while
+// keeping the per-check cost negligible.
+const parallelChunkSize = 16
+
+// MapSliceParallelCtx maps a slice like MapSlice, spreading the elements over several
+// goroutines, and stops early when ctx is canceled. It suits large request-scoped bulk
+// operations whose mapping functions are expensive enough to benefit from parallelism.
+//
+// Workers take chunks of a few elements at a time and check ctx before each chunk, so once
+// ctx is canceled each worker finishes at most its current chunk. The partial results are
+// then discarded and ctx.Err() is returned. Elements are mapped in no particular order, so
+// the mapping function must be safe for concurrent use; each result is still stored at
+// the index of its source element.
+//
+// Type Parameters:
+//   - S: Source slice type (e.g., []SourceType, []*SourceType)
+//   - D: Destination slice type (e.g., []DestType, []*DestType)
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - ctx: The context whose cancellation stops the mapping
+//   - src: The source slice to be mapped
+//   - workers: The number of goroutines to use; values below 1 mean runtime.GOMAXPROCS(0)
+//
+// Returns:
+//   - D: A new slice containing the mapped elements, nil and empty slices mapping as in
+//     MapSlice; the zero value of D on error
+//   - error: ctx.Err() if ctx is canceled before all elements are mapped, or any error
+//     MapSlice would return
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, enrichOrder)
+//
+//	dtos, err := MapSliceParallelCtx[[]Order, []OrderDTO](mapper, r.Context(), orders, 8)
+//	if errors.Is(err, context.Canceled) {
+//	    return // client went away
+//	}
+func MapSliceParallelCtx[S any, D any](m Mapper, ctx context.Context, src S, workers int) (D, error) {
+	var dst D
+
+	srcType := reflect.TypeOf(src)
+	dstType := typeOf[D]()
+	if !isSlice(srcType) || !isSlice(dstType) {
+		return dst, &SliceTypeError{Func: "MapSliceParallelCtx", Src: srcType, Dst: dstType}
+	}
+	if err := ctx.Err(); err != nil {
+		return dst, err
+	}
+
+	srcValue := reflect.ValueOf(src)
+	key := pairOf(srcType.Elem(), dstType.Elem())
+	fn, ok := m.registry.get(key)
+	if !ok {
+		if st := m.config.stats; st != nil {
+			st.misses.Add(1)
+		}
+		return dst, ErrNoMapping
+	}
+	if st := m.config.stats; st != nil {
+		st.sliceHits.Add(1)
+	}
+	if srcValue.IsNil() {
+		return dst, nil
+	}
+
+	n := srcValue.Len()
+	chunks := (n + parallelChunkSize - 1) / parallelChunkSize
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, chunks)
+
+	dstSlice := reflect.MakeSlice(dstType, n, n)
+	nilDefault := m.config.nilDefaults[key]
+
+	var (
+		next      atomic.Int64
+		done      atomic.Int64
+		stop      atomic.Bool
+		panicOnce sync.Once
+		panicked  any
+		wg        sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					stop.Store(true)
+					panicOnce.Do(func() { panicked = r })
+				}
+			}()
+
+			for !stop.Load() && ctx.Err() == nil {
+				chunk := int(next.Add(1)) - 1
+				if chunk >= chunks {
+					return
+				}
+				lo := chunk * parallelChunkSize
+				hi := min(lo+parallelChunkSize, n)
+				fillSlice(fn, srcValue.Slice(lo, hi), dstSlice.Slice(lo, hi), nilDefault)
+				postProcessSlice(m, srcValue.Slice(lo, hi), dstSlice.Slice(lo, hi))
+				done.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if panicked != nil {
+		// Re-raise in the caller's goroutine so that failures become errors exactly as in
+		// MapSlice and other panics reach the caller
+		err := func() (err error) {
+			defer recoverSignatureMismatch(srcType.Elem(), dstType.Elem(), &err)
+			panic(panicked)
+		}()
+		return dst, err
+	}
+	if int(done.Load()) < chunks {
+		// Workers only stop short of the last chunk when ctx is canceled
+		return dst, ctx.Err()
+	}
+	return dstSlice.Interface().(D), nil
+}
+
+// MapTask is one independent mapping run by MapManyConcurrent: a source value and the
+// destination the result is stored in. Create tasks with NewMapTask; the zero MapTask does
+// nothing.
+type MapTask struct {
+	run func(m Mapper) error
+}
+
+// NewMapTask creates a MapTask that maps src to D like Map and stores the result in *dst.
+// Tasks of different source and destination types can be run together.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - src: The source value to be mapped
+//   - dst: Pointer to the destination; it is only written if the mapping succeeds
+//
+// Returns:
+//   - MapTask: The task to pass to MapManyConcurrent
+func NewMapTask[S any, D any](src S, dst *D) MapTask {
+	return MapTask{run: func(m Mapper) error {
+		if dst == nil {
+			return ErrNilDestination
+		}
+		mapped, err := Map[S, D](m, src)
+		if err != nil {
+			return err
+		}
+		*dst = mapped
+		return nil
+	}}
+}
+
+// MapManyConcurrent runs independent mapping tasks in parallel and waits for all of them.
+// It speeds up assembling a response from several unrelated objects whose mapping
+// functions are expensive, such as ones that call other services.
+//
+// Every task starts at once. A mapping function cannot be interrupted, so tasks already
+// running when another fails finish anyway; the first failure only skips tasks whose
+// goroutine has not reached its mapping yet. Use MapManyConcurrentLimit to bound the
+// number of tasks running at once, which also lets a failure skip the tasks still
+// waiting. Destinations of failed and skipped tasks are left unchanged. The mapping
+// functions involved must be safe for concurrent use. A panic in a mapping function is
+// re-raised in the caller once all running tasks have finished.
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - tasks: The tasks to run, created with NewMapTask
+//
+// Returns:
+//   - error: nil if every task succeeded, or the first error returned by a task, prefixed
+//     with the task's index; it wraps the error from Map, or ErrNilDestination for a nil
+//     destination pointer
+//
+// Example:
+//
+//	var user UserDTO
+//	var orders []OrderDTO
+//	var stats StatsDTO
+//	err := MapManyConcurrent(mapper,
+//	    NewMapTask(u, &user),
+//	    NewMapTask(o, &orders),
+//	    NewMapTask(s, &stats),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+func MapManyConcurrent(m Mapper, tasks ...MapTask) error {
+	return MapManyConcurrentLimit(m, len(tasks), tasks...)
+}
+
+// MapManyConcurrentLimit is like MapManyConcurrent, but runs at most limit tasks at a
+// time. Tasks are started in order as running ones finish, and the first task to fail
+// stops the tasks that have not started yet; tasks already running still finish.
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - limit: The maximum number of tasks running at once; values below 1 mean no limit
+//   - tasks: The tasks to run, created with NewMapTask
+//
+// Returns:
+//   - error: nil if every task succeeded, or the first error returned by a task, as for
+//     MapManyConcurrent
+//
+// Example:
+//
+//	tasks := make([]MapTask, len(users))
+//	for i, u := range users {
+//	    tasks[i] = NewMapTask(u, &dtos[i])
+//	}
+//	err := MapManyConcurrentLimit(mapper, 4, tasks...)
+func MapManyConcurrentLimit(m Mapper, limit int, tasks ...MapTask) error {
+	if limit < 1 || limit > len(tasks) {
+		limit = len(tasks)
+	}
+
+	var (
+		next      atomic.Int64
+		stop      atomic.Bool
+		firstOnce sync.Once
+		panicOnce sync.Once
+		first     error
+		panicked  any
+		wg        sync.WaitGroup
+	)
+	// run claims and runs tasks in order until none are left or one has failed
+	run := func() {
+		defer wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				stop.Store(true)
+				panicOnce.Do(func() { panicked = r })
+			}
+		}()
+
+		for !stop.Load() {
+			i := int(next.Add(1)) - 1
+			if i >= len(tasks) {
+				return
+			}
+			if tasks[i].run == nil {
+				continue
+			}
+			if err := tasks[i].run(m); err != nil {
+				stop.Store(true)
+				firstOnce.Do(func() { first = fmt.Errorf("task %d: %w", i, err) })
+			}
+		}
+	}
+	for w := 0; w < limit; w++ {
+		wg.Add(1)
+		go run()
+	}
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+	return first
+}
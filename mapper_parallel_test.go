@@ -0,0 +1,288 @@
+package mapper
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestMapSliceParallelCtx tests parallel slice mapping that stops on context cancellation
+func TestMapSliceParallelCtx(t *testing.T) {
+	persons := make([]Person, 1000)
+	for i := range persons {
+		persons[i] = Person{Name: "Person", Age: i}
+	}
+
+	t.Run("MapsAllElementsInOrder", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapSliceParallelCtx[[]Person, []*PersonDTO](mapper, context.Background(), persons, 4)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != len(persons) {
+			t.Fatalf("Expected %d elements, got %d", len(persons), len(got))
+		}
+		for i, dto := range got {
+			if dto.Years != i {
+				t.Fatalf("Expected element %d to have Years %d, got %d", i, i, dto.Years)
+			}
+		}
+	})
+
+	t.Run("NilAndEmptySlices", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapSliceParallelCtx[[]Person, []PersonDTO](mapper, context.Background(), nil, 0)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil slice, got %v, %v", got, err)
+		}
+		got, err = MapSliceParallelCtx[[]Person, []PersonDTO](mapper, context.Background(), []Person{}, 0)
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("Expected empty non-nil slice, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("CancellationReturnsEarly", func(t *testing.T) {
+		mapper := New()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls atomic.Int64
+		Register(mapper, func(p Person) PersonDTO {
+			if calls.Add(1) == 1 {
+				cancel()
+			}
+			time.Sleep(100 * time.Microsecond)
+			return personToDTO(p)
+		})
+
+		got, err := MapSliceParallelCtx[[]Person, []PersonDTO](mapper, ctx, persons, 2)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if got != nil {
+			t.Errorf("Expected partial results to be discarded, got %d elements", len(got))
+		}
+		if n := calls.Load(); n > 2*parallelChunkSize {
+			t.Errorf("Expected at most %d calls after cancellation, got %d", 2*parallelChunkSize, n)
+		}
+	})
+
+	t.Run("CanceledBeforeStart", func(t *testing.T) {
+		mapper := New()
+		var calls atomic.Int64
+		Register(mapper, func(p Person) PersonDTO { calls.Add(1); return personToDTO(p) })
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := MapSliceParallelCtx[[]Person, []PersonDTO](mapper, ctx, persons, 2); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if calls.Load() != 0 {
+			t.Errorf("Expected no calls, got %d", calls.Load())
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mapper := New()
+		ctx := context.Background()
+
+		if _, err := MapSliceParallelCtx[[]Person, []PersonDTO](mapper, ctx, persons, 2); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		var typeErr *SliceTypeError
+		if _, err := MapSliceParallelCtx[Person, []PersonDTO](mapper, ctx, Person{}, 2); !errors.As(err, &typeErr) {
+			t.Errorf("Expected *SliceTypeError, got %v", err)
+		}
+
+		mapper.registry.set(keyOf[Person, PersonDTO](), stringToInt)
+		if _, err := MapSliceParallelCtx[[]Person, []PersonDTO](mapper, ctx, persons, 2); !errors.Is(err, ErrSignatureMismatch) {
+			t.Errorf("Expected ErrSignatureMismatch, got %v", err)
+		}
+	})
+
+	t.Run("MatchesMapSlice", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		want, _ := MapSlice[[]Person, []PersonDTO](mapper, persons)
+		got, err := MapSliceParallelCtx[[]Person, []PersonDTO](mapper, context.Background(), persons, 0)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected the same result as MapSlice, got error %v", err)
+		}
+	})
+}
+
+// TestMapManyConcurrent tests running independent mappings in parallel
+func TestMapManyConcurrent(t *testing.T) {
+	newMapper := func() Mapper {
+		mapper := New()
+		Register(mapper, personToDTO)
+		Register(mapper, stringToInt)
+		Register(mapper, intToString)
+		return mapper
+	}
+
+	t.Run("MapsEveryTask", func(t *testing.T) {
+		var dto PersonDTO
+		var n int
+		var s string
+
+		err := MapManyConcurrent(newMapper(),
+			NewMapTask(Person{Name: "Ann", Age: 30}, &dto),
+			NewMapTask("hello", &n),
+			NewMapTask(42, &s),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto.FullName != "Ann" || n != 5 || s != "number-42" {
+			t.Errorf("Expected {Ann 30}, 5 and number-42, got %v, %d and %q", dto, n, s)
+		}
+	})
+
+	t.Run("ReturnsFailingTask", func(t *testing.T) {
+		var dto PersonDTO
+		unmapped := 7
+		var s string
+
+		err := MapManyConcurrent(newMapper(),
+			NewMapTask(Person{Name: "Ann"}, &dto),
+			NewMapTask(3.5, &unmapped),
+			NewMapTask(42, &s),
+		)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Fatalf("Expected ErrNoMapping, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "task 1") {
+			t.Errorf("Expected error to name task 1, got %v", err)
+		}
+		if unmapped != 7 {
+			t.Errorf("Expected failed destination to be unchanged, got %d", unmapped)
+		}
+	})
+
+	t.Run("RunsInParallel", func(t *testing.T) {
+		type ping struct{}
+		type pong struct{}
+
+		// Each mapping waits for the other to start, which only completes if they overlap
+		started := make(chan struct{}, 2)
+		await := func() {
+			started <- struct{}{}
+			deadline := time.After(time.Second)
+			for len(started) < 2 {
+				select {
+				case <-deadline:
+					return
+				default:
+					time.Sleep(time.Millisecond)
+				}
+			}
+		}
+		mapper := New()
+		Register(mapper, func(ping) int { await(); return len(started) })
+		Register(mapper, func(pong) string { await(); return "done" })
+
+		var n int
+		var s string
+		if err := MapManyConcurrent(mapper, NewMapTask(ping{}, &n), NewMapTask(pong{}, &s)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if n != 2 {
+			t.Errorf("Expected both tasks to run concurrently, got %d started", n)
+		}
+	})
+
+	t.Run("RunningTasksFinishAfterFailure", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		started := make(chan struct{})
+		mapper := New()
+		Register(mapper, func(i int) string {
+			close(started)
+			time.Sleep(5 * time.Millisecond)
+			return "done"
+		})
+		RegisterE(mapper, func(f float64) (int, error) {
+			<-started
+			return 0, errBoom
+		})
+
+		var slow string
+		var failed int
+		err := MapManyConcurrent(mapper, NewMapTask(1, &slow), NewMapTask(2.5, &failed))
+		if !errors.Is(err, errBoom) {
+			t.Fatalf("Expected boom, got %v", err)
+		}
+		if slow != "done" {
+			t.Errorf("Expected the running task to finish, got %q", slow)
+		}
+	})
+
+	t.Run("LimitSkipsTasksAfterFailure", func(t *testing.T) {
+		unmapped := 7
+		n := -1
+
+		err := MapManyConcurrentLimit(newMapper(), 1,
+			NewMapTask(3.5, &unmapped),
+			NewMapTask("hello", &n),
+		)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Fatalf("Expected ErrNoMapping, got %v", err)
+		}
+		if n != -1 {
+			t.Errorf("Expected the task after the failure to be skipped, got %d", n)
+		}
+	})
+
+	t.Run("LimitBoundsConcurrency", func(t *testing.T) {
+		var running, peak atomic.Int32
+		mapper := New()
+		Register(mapper, func(i int) string {
+			now := running.Add(1)
+			for {
+				old := peak.Load()
+				if now <= old || peak.CompareAndSwap(old, now) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			return "done"
+		})
+
+		results := make([]string, 6)
+		tasks := make([]MapTask, len(results))
+		for i := range tasks {
+			tasks[i] = NewMapTask(i, &results[i])
+		}
+		if err := MapManyConcurrentLimit(mapper, 2, tasks...); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := peak.Load(); got > 2 {
+			t.Errorf("Expected at most 2 tasks at once, got %d", got)
+		}
+		for i, r := range results {
+			if r != "done" {
+				t.Errorf("Expected task %d to run, got %q", i, r)
+			}
+		}
+	})
+
+	t.Run("NilDestinationAndZeroTask", func(t *testing.T) {
+		err := MapManyConcurrent(newMapper(), MapTask{}, NewMapTask[string, int]("x", nil))
+		if !errors.Is(err, ErrNilDestination) {
+			t.Errorf("Expected ErrNilDestination, got %v", err)
+		}
+		if err := MapManyConcurrent(newMapper()); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
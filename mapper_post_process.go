@@ -0,0 +1,116 @@
+package mapper
+
+import (
+	"reflect"
+)
+
+// RegisterPostProcess registers fn to run on every value mapped to D, whatever the source
+// type. It centralizes cross-cutting adjustments of mapped values, such as stamping a
+// MappedAt time on every AuditLog, that would otherwise be repeated in each mapping
+// function producing D.
+//
+// fn receives a pointer to the mapped value after the mapping function has run and may
+// modify it in place. It is called by Map and the helpers built on it, by MapSlice,
+// MapElems, MapSliceAppend, MapSliceFlatten and MapSliceParallelCtx for each element, and
+// by MapChan, MapMapValues and MapMapKeys. Results that no mapping function produced are
+// skipped: nil pointer sources, which map to nil, the zero value or a nil default, and nil
+// pointers returned by the mapping function. Like Register, D is keyed with one level of
+// pointer indirection stripped, so fn also runs for *D destinations. Several functions
+// registered for the same D run in registration order. MapSliceParallelCtx calls fn from
+// several goroutines.
+//
+// Type Parameters:
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance to register the post-processing function with
+//   - fn: The function adjusting mapped values in place
+//
+// Panics:
+//   - If fn is nil, with an error wrapping ErrNilMappingFunc
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(l Login) AuditLog { return AuditLog{Event: "login", User: l.User} })
+//	RegisterPostProcess(mapper, func(a *AuditLog) { a.MappedAt = time.Now() })
+//
+//	entry, _ := Map[Login, AuditLog](mapper, login)
+//	fmt.Println(entry.MappedAt.IsZero()) // Output: false
+func RegisterPostProcess[D any](m Mapper, fn func(*D)) {
+	dstType := derefType(typeOf[D]())
+	if fn == nil {
+		panic(nilFuncError(typePair{src: dstType, dst: dstType}))
+	}
+
+	if m.config.postProcess == nil {
+		m.config.postProcess = make(map[reflect.Type][]func(reflect.Value))
+	}
+	m.config.postProcess[dstType] = append(m.config.postProcess[dstType], func(ptr reflect.Value) {
+		if typeOf[D]().Kind() == reflect.Pointer {
+			// Registered for a pointer type: ptr already is a D
+			d := ptr.Interface().(D)
+			fn(&d)
+			return
+		}
+		fn(ptr.Interface().(*D))
+	})
+}
+
+// postProcess returns the mapped value dst after running the post-processing functions
+// registered for its type, unless src, the value it was mapped from, is a nil pointer.
+func postProcess[S any, D any](m Mapper, src S, dst D) D {
+	if len(m.config.postProcess) == 0 {
+		return dst
+	}
+	return postProcessValue(m, src, dst)
+}
+
+// postProcessValue implements postProcess for a mapper with post-processing functions. It
+// is kept separate so that dst only escapes to the heap when there is work to do.
+func postProcessValue[S any, D any](m Mapper, src S, dst D) D {
+	if typeOf[S]().Kind() == reflect.Pointer && reflect.ValueOf(src).IsNil() {
+		return dst
+	}
+	runPostProcess(m.config.postProcess[derefType(typeOf[D]())], reflect.ValueOf(&dst).Elem())
+	return dst
+}
+
+// postProcessSlice runs the post-processing functions registered for the element type of
+// dstSlice on each element whose source element in srcValue is not a nil pointer.
+func postProcessSlice(m Mapper, srcValue, dstSlice reflect.Value) {
+	if len(m.config.postProcess) == 0 {
+		return
+	}
+	fns := m.config.postProcess[derefType(dstSlice.Type().Elem())]
+	if len(fns) == 0 {
+		return
+	}
+
+	nilable := srcValue.Type().Elem().Kind() == reflect.Pointer
+	for i := 0; i < srcValue.Len(); i++ {
+		if nilable && srcValue.Index(i).IsNil() {
+			continue
+		}
+		runPostProcess(fns, dstSlice.Index(i))
+	}
+}
+
+// runPostProcess calls fns on the addressable mapped value v, or on the value v points to.
+// A nil pointer is skipped.
+func runPostProcess(fns []func(reflect.Value), v reflect.Value) {
+	if len(fns) == 0 {
+		return
+	}
+
+	ptr := v.Addr()
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		ptr = v
+	}
+	for _, fn := range fns {
+		fn(ptr)
+	}
+}
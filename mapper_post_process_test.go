@@ -0,0 +1,140 @@
+package mapper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// auditLog is a destination type stamped by post-processing in tests
+type auditLog struct {
+	Event    string
+	MappedAt time.Time
+}
+
+// TestRegisterPostProcess tests post-processing mapped values by destination type
+func TestRegisterPostProcess(t *testing.T) {
+	stamp := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	newMapper := func() Mapper {
+		mapper := New()
+		Register(mapper, func(s string) auditLog { return auditLog{Event: s} })
+		Register(mapper, func(p Person) *auditLog { return &auditLog{Event: "person " + p.Name} })
+		RegisterPostProcess(mapper, func(a *auditLog) { a.MappedAt = stamp })
+		return mapper
+	}
+
+	t.Run("RunsForEverySource", func(t *testing.T) {
+		mapper := newMapper()
+
+		fromString, err := Map[string, auditLog](mapper, "login")
+		if err != nil || !fromString.MappedAt.Equal(stamp) {
+			t.Errorf("Expected stamped login, got %v, %v", fromString, err)
+		}
+		fromPerson, err := Map[*Person, *auditLog](mapper, &Person{Name: "Ann"})
+		if err != nil || fromPerson == nil || !fromPerson.MappedAt.Equal(stamp) {
+			t.Errorf("Expected stamped person, got %v, %v", fromPerson, err)
+		}
+	})
+
+	t.Run("RunsInRegistrationOrder", func(t *testing.T) {
+		mapper := newMapper()
+		RegisterPostProcess(mapper, func(a *auditLog) { a.Event += " at " + a.MappedAt.Format(time.DateOnly) })
+
+		got, _ := Map[string, auditLog](mapper, "login")
+		if got.Event != "login at 2024-05-01" {
+			t.Errorf("Expected login at 2024-05-01, got %q", got.Event)
+		}
+	})
+
+	t.Run("RegisteredForPointerType", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(s string) auditLog { return auditLog{Event: s} })
+		RegisterPostProcess(mapper, func(a **auditLog) { (*a).MappedAt = stamp })
+
+		got, _ := Map[string, auditLog](mapper, "login")
+		if !got.MappedAt.Equal(stamp) {
+			t.Errorf("Expected stamped log, got %v", got)
+		}
+	})
+
+	t.Run("SkipsNilResults", func(t *testing.T) {
+		mapper := newMapper()
+		Register(mapper, func(p Person) *auditLog { return nil })
+
+		if got, err := Map[*Person, auditLog](mapper, nil); err != nil || !got.MappedAt.IsZero() {
+			t.Errorf("Expected unstamped zero value, got %v, %v", got, err)
+		}
+		if got, err := Map[Person, *auditLog](mapper, Person{}); err != nil || got != nil {
+			t.Errorf("Expected nil, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("SkipsFailedMappings", func(t *testing.T) {
+		mapper := New()
+		errBoom := errors.New("boom")
+		RegisterE(mapper, func(s string) (auditLog, error) { return auditLog{}, errBoom })
+		called := false
+		RegisterPostProcess(mapper, func(a *auditLog) { called = true })
+
+		if _, err := Map[string, auditLog](mapper, "login"); !errors.Is(err, errBoom) || called {
+			t.Errorf("Expected boom without post-processing, got %v, called %v", err, called)
+		}
+	})
+
+	t.Run("RunsForSliceElements", func(t *testing.T) {
+		mapper := newMapper()
+		people := []*Person{{Name: "Ann"}, nil, {Name: "Bob"}}
+
+		check := func(t *testing.T, logs []auditLog) {
+			t.Helper()
+			want := []bool{true, false, true}
+			if len(logs) != len(want) {
+				t.Fatalf("Expected %d logs, got %v", len(want), logs)
+			}
+			for i, stamped := range want {
+				if logs[i].MappedAt.Equal(stamp) != stamped {
+					t.Errorf("Expected element %d stamped %v, got %v", i, stamped, logs[i])
+				}
+			}
+		}
+
+		logs, err := MapSlice[[]*Person, []auditLog](mapper, people)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		check(t, logs)
+
+		logs, err = MapElems[*Person, auditLog](mapper, people)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		check(t, logs)
+
+		logs, err = MapSliceAppend(mapper, people, []auditLog(nil))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		check(t, logs)
+
+		logs, err = MapSliceParallelCtx[[]*Person, []auditLog](mapper, context.Background(), people, 2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		check(t, logs)
+
+		fast, err := MapElems[string, auditLog](mapper, []string{"a", "b"})
+		if err != nil || !fast[0].MappedAt.Equal(stamp) || !fast[1].MappedAt.Equal(stamp) {
+			t.Errorf("Expected stamped logs, got %v, %v", fast, err)
+		}
+	})
+
+	t.Run("NilFunctionPanics", func(t *testing.T) {
+		defer func() {
+			if err, _ := recover().(error); !errors.Is(err, ErrNilMappingFunc) {
+				t.Errorf("Expected panic with ErrNilMappingFunc, got %v", err)
+			}
+		}()
+		RegisterPostProcess[auditLog](New(), nil)
+	})
+}
@@ -0,0 +1,112 @@
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Prepare verifies at startup that an S -> D mapping is registered and can be called for
+// these static types. It gives latency-sensitive services a deterministic warm-up phase:
+// configuration mistakes surface before the first request instead of during it.
+//
+// The mapper keeps no lazily populated per-pair caches: type lookups are cheaper than a
+// memoized table and auto-map field plans are built at registration time. Once Prepare
+// returns nil, the first Map[S, D] call therefore does the same work as every later call.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//
+// Returns:
+//   - error: ErrNoMapping if no mapping function is registered for S -> D, or an error
+//     wrapping ErrSignatureMismatch if the registered function cannot convert S to D
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(u User) UserDTO { return UserDTO{Name: u.Name} })
+//
+//	if err := Prepare[User, UserDTO](mapper); err != nil {
+//	    log.Fatal(err)
+//	}
+func Prepare[S any, D any](m Mapper) error {
+	fn, ok := lookup[S, D](m)
+	if !ok {
+		return ErrNoMapping
+	}
+	if _, fast := fn.(func(S) D); fast {
+		return nil
+	}
+	return checkSignature(reflect.TypeOf(fn), typeOf[S](), typeOf[D]())
+}
+
+// Validate checks every registered mapping function against the type pair it is stored
+// under and returns one error per function that cannot serve its pair. It is a startup
+// self-check complementing Prepare: rather than verifying the pairs a service uses, it
+// catches registry entries that are inconsistent with their key, which Map would only
+// report when the pair is first mapped.
+//
+// A function serves a pair if it takes one argument and returns one result whose types,
+// after stripping one level of pointer indirection, are the pair's source type and a type
+// assignable to its destination type. Conditional mappings and the fallback are not
+// checked.
+//
+// Parameters:
+//   - m: The mapper instance to validate
+//
+// Returns:
+//   - []error: One error per invalid entry, each wrapping ErrSignatureMismatch, or
+//     ErrNilMappingFunc for a nil entry, ordered by source and destination type name;
+//     nil if every entry is valid
+//
+// Example:
+//
+//	mapper := New()
+//	registerMappings(mapper)
+//
+//	if errs := Validate(mapper); len(errs) > 0 {
+//	    log.Fatal(errors.Join(errs...))
+//	}
+func Validate(m Mapper) []error {
+	entries := m.registry.snapshot()
+	keys := make([]typePair, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].src.String()+"-"+keys[i].dst.String() < keys[j].src.String()+"-"+keys[j].dst.String()
+	})
+
+	var errs []error
+	for _, k := range keys {
+		fn := entries[k]
+		if fn == nil {
+			errs = append(errs, nilFuncError(k))
+			continue
+		}
+		if err := checkSignature(reflect.TypeOf(fn), k.src, k.dst); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// checkSignature reports whether a function of type fnType can be called by the reflection
+// path to map src to dst, allowing one level of pointer indirection on either side. A
+// parameter of an interface type that src implements, as registered by RegisterMulti, is
+// accepted too.
+func checkSignature(fnType, src, dst reflect.Type) error {
+	if fnType.Kind() != reflect.Func || fnType.NumIn() != 1 || fnType.NumOut() != 1 {
+		return fmt.Errorf("%w for %s->%s: registered %s", ErrSignatureMismatch, src, dst, fnType)
+	}
+	in := fnType.In(0)
+	boxed := in.Kind() == reflect.Interface && derefType(src).Implements(in)
+	if !boxed && derefType(in) != derefType(src) || !derefType(fnType.Out(0)).AssignableTo(derefType(dst)) {
+		return fmt.Errorf("%w for %s->%s: registered %s", ErrSignatureMismatch, src, dst, fnType)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package mapper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestPrepare tests verifying mappings ahead of the first Map call
+func TestPrepare(t *testing.T) {
+	t.Run("RegisteredMapping", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		if err := Prepare[Person, PersonDTO](mapper); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("PointerTypesUseValueMapping", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		if err := Prepare[*Person, *PersonDTO](mapper); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if err := Prepare[*Person, PersonDTO](mapper); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("UnregisteredReturnsErrNoMapping", func(t *testing.T) {
+		mapper := New()
+
+		if err := Prepare[Person, PersonDTO](mapper); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("FallbackIsNotARegistration", func(t *testing.T) {
+		mapper := New()
+		SetFallback(mapper, jsonFallback)
+
+		if err := Prepare[Person, PersonDTO](mapper); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("MismatchedFunctionReturnsError", func(t *testing.T) {
+		mapper := New()
+		mapper.registry.set(keyOf[Person, PersonDTO](), stringToInt)
+
+		err := Prepare[Person, PersonDTO](mapper)
+		if !errors.Is(err, ErrSignatureMismatch) {
+			t.Errorf("Expected ErrSignatureMismatch, got %v", err)
+		}
+	})
+
+	t.Run("AutoMapping", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[Person, PersonDTO](mapper)
+
+		if err := Prepare[Person, PersonDTO](mapper); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if err := Prepare[PersonDTO, Person](mapper); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
+
+// TestValidate tests checking registry entries against their type pairs
+func TestValidate(t *testing.T) {
+	t.Run("ValidRegistry", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		Register(mapper, func(p *Person) *PersonDTO { return &PersonDTO{FullName: p.Name} })
+		RegisterAutoMap[Person, PersonDTO](mapper)
+		Register(mapper, stringToInt)
+
+		if errs := Validate(mapper); errs != nil {
+			t.Errorf("Expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("EmptyRegistry", func(t *testing.T) {
+		if errs := Validate(New()); errs != nil {
+			t.Errorf("Expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("MismatchedEntries", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+		mapper.registry.set(keyOf[Person, PersonDTO](), stringToInt)
+		mapper.registry.set(keyOf[int, string](), "not a function")
+		mapper.registry.set(keyOf[Person, string](), nil)
+
+		errs := Validate(mapper)
+		if len(errs) != 3 {
+			t.Fatalf("Expected 3 errors, got %d: %v", len(errs), errs)
+		}
+		if !errors.Is(errs[0], ErrSignatureMismatch) || !strings.Contains(errs[0].Error(), "int->string") {
+			t.Errorf("Expected int->string mismatch first, got %v", errs[0])
+		}
+		if !errors.Is(errs[1], ErrSignatureMismatch) || !strings.Contains(errs[1].Error(), "func(string) int") {
+			t.Errorf("Expected mismatch naming func(string) int, got %v", errs[1])
+		}
+		if !errors.Is(errs[2], ErrNilMappingFunc) {
+			t.Errorf("Expected ErrNilMappingFunc, got %v", errs[2])
+		}
+	})
+}
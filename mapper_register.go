@@ -0,0 +1,399 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNotConvertible is returned by RegisterConvertible when the source type cannot be
+// converted to the destination type with a Go conversion.
+var ErrNotConvertible = errors.New("source type is not convertible to destination type")
+
+// RegisterMany registers several mapping functions at once. Each argument must be a
+// function of the form func(S) D; it is stored under the same S -> D key that Register
+// would use, with pointers stripped. This keeps large mapping setups in init functions
+// short.
+//
+// All arguments are validated before anything is registered: if any of them is invalid,
+// the registry is left untouched and the returned error lists every offending argument
+// by index. Each individual error wraps ErrInvalidMappingFunc.
+//
+// Parameters:
+//   - m: The mapper instance to register the functions with
+//   - fns: The mapping functions to register
+//
+// Returns:
+//   - error: nil on success, or an error describing every invalid argument
+//
+// Example:
+//
+//	mapper := New()
+//	err := RegisterMany(mapper,
+//	    func(s string) int { return len(s) },
+//	    func(i int) string { return strconv.Itoa(i) },
+//	    func(p Person) PersonDTO { return PersonDTO{Name: p.Name} },
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func RegisterMany(m Mapper, fns ...any) error {
+	keys := make([]typePair, len(fns))
+	var errs []error
+
+	for i, fn := range fns {
+		key, err := funcPair(fn)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w at index %d: %w", ErrInvalidMappingFunc, i, err))
+			continue
+		}
+		keys[i] = key
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	m.registry.update(func(entries map[typePair]any, kinds map[typePair]entryKind) {
+		for i, fn := range fns {
+			entries[keys[i]] = fn
+			delete(kinds, keys[i])
+		}
+	})
+	return nil
+}
+
+// Box returns fn in the form the registry stores it: the function itself as an interface
+// value, without any wrapper. Boxed functions can be collected in mapping tables built
+// outside the mapper and registered in bulk with RegisterMany, which stores each one under
+// the same key Register would.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - fn: The mapping function to box
+//
+// Returns:
+//   - any: fn as stored by the registry; a nil fn is boxed as a typed nil that RegisterMany
+//     rejects
+//
+// Example:
+//
+//	table := []any{
+//	    Box(func(s string) int { return len(s) }),
+//	    Box(func(p Person) PersonDTO { return PersonDTO{Name: p.Name} }),
+//	}
+//	if err := RegisterMany(mapper, table...); err != nil {
+//	    log.Fatal(err)
+//	}
+func Box[S any, D any](fn func(S) D) any {
+	return fn
+}
+
+// Unbox recovers a mapping function from its boxed form, the inverse of Box. It succeeds
+// only if v holds a non-nil func(S) D for exactly these S and D: a function registered in
+// another pointer form, such as func(*S) D, is stored under the same key but does not
+// unbox as func(S) D.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - v: The boxed mapping function
+//
+// Returns:
+//   - func(S) D: The mapping function
+//   - bool: Whether v holds a non-nil func(S) D
+//
+// Example:
+//
+//	boxed := Box(func(s string) int { return len(s) })
+//	if fn, ok := Unbox[string, int](boxed); ok {
+//	    fmt.Println(fn("hello")) // Output: 5
+//	}
+func Unbox[S any, D any](v any) (func(S) D, bool) {
+	fn, ok := v.(func(S) D)
+	return fn, ok && fn != nil
+}
+
+// funcPair derives the registry key of a dynamically typed mapping function and reports
+// why the value cannot be registered if it is not a func(S) D.
+func funcPair(fn any) (typePair, error) {
+	if fn == nil {
+		return typePair{}, ErrNilMappingFunc
+	}
+
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+	if fnType.Kind() != reflect.Func {
+		return typePair{}, fmt.Errorf("got %s, want func(S) D", fnType)
+	}
+	if fnType.NumIn() != 1 || fnType.NumOut() != 1 || fnType.IsVariadic() {
+		return typePair{}, fmt.Errorf("got %s, want exactly one parameter and one result", fnType)
+	}
+	if fnValue.IsNil() {
+		return typePair{}, fmt.Errorf("%w %s", ErrNilMappingFunc, fnType)
+	}
+
+	return pairOf(fnType.In(0), fnType.Out(0)), nil
+}
+
+// RegisterProjection registers an S -> []D mapping built from an extractor that pulls a
+// slice of elements out of S and an element mapper that converts each element to D.
+// It covers flattening cases such as mapping a Team to the DTOs of its members, which are
+// too specialized for automatic mapping. After registration, Map[S, []D] works through
+// the normal registry lookup.
+//
+// A nil slice returned by extract maps to a nil []D. RegisterProjection panics if either
+// function is nil, like Register.
+//
+// Type Parameters:
+//   - S: Source type
+//   - E: Element type extracted from the source
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance to register the projection with
+//   - extract: Function returning the elements of the source to project
+//   - elemFn: Function mapping each extracted element to D
+//
+// Example:
+//
+//	mapper := New()
+//	RegisterProjection(mapper,
+//	    func(t Team) []Person { return t.Members },
+//	    func(p Person) MemberDTO { return MemberDTO{Name: p.Name} },
+//	)
+//
+//	members, err := Map[Team, []MemberDTO](mapper, team)
+func RegisterProjection[S any, E any, D any](m Mapper, extract func(S) []E, elemFn func(E) D) {
+	if extract == nil || elemFn == nil {
+		panic(nilFuncError(typePair{src: typeOf[S](), dst: typeOf[[]D]()}))
+	}
+
+	Register(m, func(src S) []D {
+		elems := extract(src)
+		if elems == nil {
+			return nil
+		}
+
+		dst := make([]D, len(elems))
+		for i, elem := range elems {
+			dst[i] = elemFn(elem)
+		}
+		return dst
+	})
+}
+
+// RegisterPair registers a forward S -> D and a reverse D -> S mapping function in one
+// call. It is the manual counterpart of RegisterAutoMap and keeps symmetric mappings
+// together, which makes their intent obvious in code review. Like Register, it panics if
+// either function is nil.
+//
+// Type Parameters:
+//   - S: Source type of the forward mapping
+//   - D: Destination type of the forward mapping
+//
+// Parameters:
+//   - m: The mapper instance to register the functions with
+//   - forward: The function converting S to D
+//   - reverse: The function converting D back to S
+//
+// Example:
+//
+//	mapper := New()
+//	RegisterPair(mapper,
+//	    func(p Person) PersonDTO { return PersonDTO{FullName: p.Name} },
+//	    func(d PersonDTO) Person { return Person{Name: d.FullName} },
+//	)
+//
+//	dto, _ := Map[Person, PersonDTO](mapper, person)
+//	back, _ := Map[PersonDTO, Person](mapper, dto)
+func RegisterPair[S any, D any](m Mapper, forward func(S) D, reverse func(D) S) {
+	Register(m, forward)
+	Register(m, reverse)
+}
+
+// RegisterWithArg registers an S -> D mapping built from a function that also takes a
+// static argument known at registration time, such as a currency code or a locale. The
+// argument is curried into the registered func(S) D, which keeps the closure creation in
+// one place and documents the pattern at the call site.
+//
+// Type Parameters:
+//   - S: Source type
+//   - A: Type of the static argument
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance to register the function with
+//   - arg: The argument passed to fn on every mapping
+//   - fn: The mapping function taking the argument and the source value
+//
+// Panics:
+//   - If fn is nil, with an error wrapping ErrNilMappingFunc
+//
+// Example:
+//
+//	mapper := New()
+//	RegisterWithArg(mapper, "EUR", func(currency string, p Price) PriceDTO {
+//	    return PriceDTO{Amount: p.Amount, Currency: currency}
+//	})
+//
+//	dto, _ := Map[Price, PriceDTO](mapper, Price{Amount: 10})
+//	fmt.Println(dto.Currency) // Output: EUR
+func RegisterWithArg[S any, A any, D any](m Mapper, arg A, fn func(A, S) D) {
+	if fn == nil {
+		panic(nilFuncError(typePair{src: typeOf[S](), dst: typeOf[D]()}))
+	}
+
+	Register(m, func(src S) D {
+		return fn(arg, src)
+	})
+}
+
+// RegisterE registers an S -> D mapping function that can fail. When fn returns an error,
+// Map and the helpers built on the registry return that error, unwrapped, instead of a
+// destination value. It is the hook for conversions whose inputs may be invalid, such as
+// parsing strings, or converting to and from fixed-precision decimal types from
+// third-party packages that the mapper does not depend on.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance to register the function with
+//   - fn: The mapping function returning the destination value or an error
+//
+// Panics:
+//   - If fn is nil, with an error wrapping ErrNilMappingFunc
+//
+// Example:
+//
+//	// Conversions for github.com/shopspring/decimal, rounding to cents
+//	mapper := New()
+//	RegisterE(mapper, func(s string) (decimal.Decimal, error) {
+//	    d, err := decimal.NewFromString(s)
+//	    return d.Round(2), err
+//	})
+//	Register(mapper, func(d decimal.Decimal) string { return d.StringFixed(2) })
+//	Register(mapper, func(f float64) decimal.Decimal { return decimal.NewFromFloat(f).Round(2) })
+//	Register(mapper, func(d decimal.Decimal) float64 { return d.InexactFloat64() })
+//
+//	price, err := Map[string, decimal.Decimal](mapper, "19.999")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(price) // Output: 20
+func RegisterE[S any, D any](m Mapper, fn func(S) (D, error)) {
+	if fn == nil {
+		panic(nilFuncError(typePair{src: typeOf[S](), dst: typeOf[D]()}))
+	}
+
+	registerFallible(m, func(src S) D {
+		dst, err := fn(src)
+		if err != nil {
+			failMapping(err)
+		}
+		return dst
+	})
+}
+
+// RegisterConvertible registers an S -> D mapping that performs the Go conversion D(src),
+// for types related by conversion such as a named type and its underlying type
+// (type Celsius float64 and float64), numeric types, or string and []byte. It saves
+// writing one trivial function per pair. Register D -> S separately for the reverse
+// direction.
+//
+// Numeric conversions follow Go semantics, so converting to a smaller type may truncate
+// or overflow. A conversion that the types allow but the value does not, such as a slice
+// shorter than the destination array, makes Map return an error wrapping
+// ErrNotConvertible.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance to register the conversion with
+//
+// Returns:
+//   - error: nil on success, or an error wrapping ErrNotConvertible if S cannot be
+//     converted to D, in which case nothing is registered
+//
+// Example:
+//
+//	type Celsius float64
+//
+//	mapper := New()
+//	if err := RegisterConvertible[Celsius, float64](mapper); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	degrees, _ := Map[Celsius, float64](mapper, Celsius(21.5))
+//	fmt.Println(degrees) // Output: 21.5
+func RegisterConvertible[S any, D any](m Mapper) error {
+	srcType, dstType := typeOf[S](), typeOf[D]()
+	if !srcType.ConvertibleTo(dstType) {
+		return fmt.Errorf("%w: %s->%s", ErrNotConvertible, srcType, dstType)
+	}
+
+	registerFallible(m, func(src S) D {
+		v := reflect.ValueOf(&src).Elem()
+		if !v.CanConvert(dstType) {
+			failMapping(fmt.Errorf("%w: %s value to %s", ErrNotConvertible, srcType, dstType))
+		}
+		return v.Convert(dstType).Interface().(D)
+	})
+	return nil
+}
+
+// registerFallible registers fn like Register and records its entry as one that can fail
+// with failMapping, so that a MapHandle knows to recover from its calls.
+func registerFallible[S any, D any](m Mapper, fn func(S) D) {
+	registerKind(m, fn, fallibleEntry)
+}
+
+// registerKind registers fn for S -> D as an entry of the given kind, and makes sure that
+// MapSliceReduce can call functions of its type without reflection.
+func registerKind[S any, D any](m Mapper, fn func(S) D, kind entryKind) {
+	types := typePair{src: typeOf[S](), dst: typeOf[D]()}
+	if _, ok := sliceFolds.Load(types); !ok {
+		sliceFolds.Store(types, foldSlice[S, D])
+	}
+	m.registry.setKind(keyOf[S, D](), fn, kind)
+}
+
+// identity returns v unchanged.
+func identity[T any](v T) T {
+	return v
+}
+
+// RegisterIdentity registers a T -> T mapping that returns the source value unchanged. It
+// suits generic pipelines that map every value through the mapper, where some types need
+// no conversion.
+//
+// Unlike an equivalent func(v T) T { return v } passed to Register, the identity mapping
+// is recognized by MapSlice and the helpers built on it: a []T mapped to a []T is filled
+// with a single copy instead of calling the function once per element. The copy is
+// shallow, as calling the function would be. Slices of pointers are still mapped element
+// by element, so each destination element keeps pointing to a fresh copy rather than
+// sharing the source's. Post-processors registered for T still run on every element.
+//
+// Type Parameters:
+//   - T: The type mapped to itself
+//
+// Parameters:
+//   - m: The mapper instance to register the mapping with
+//
+// Example:
+//
+//	mapper := New()
+//	RegisterIdentity[int](mapper)
+//
+//	ids, _ := MapSlice[[]int, []int](mapper, []int{1, 2, 3}) // one copy, no calls
+func RegisterIdentity[T any](m Mapper) {
+	registerKind(m, identity[T], identityEntry)
+}
@@ -0,0 +1,590 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestRegisterMany tests registering several mapping functions at once
+func TestRegisterMany(t *testing.T) {
+	t.Run("RegistersAllFunctions", func(t *testing.T) {
+		mapper := New()
+
+		err := RegisterMany(mapper, stringToInt, intToString, personToDTO)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !Has[string, int](mapper) || !Has[int, string](mapper) || !Has[Person, PersonDTO](mapper) {
+			t.Errorf("Expected all mappings to be registered, got %v", List(mapper))
+		}
+
+		result, err := Map[Person, PersonDTO](mapper, Person{Name: "John", Age: 30})
+		if err != nil || result.FullName != "John" {
+			t.Errorf("Expected {John 30}, got %+v (err: %v)", result, err)
+		}
+	})
+
+	t.Run("NoArguments", func(t *testing.T) {
+		mapper := New()
+
+		if err := RegisterMany(mapper); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if len(mapper.registry.snapshot()) != 0 {
+			t.Errorf("Expected empty registry, got %d items", len(mapper.registry.snapshot()))
+		}
+	})
+
+	t.Run("InvalidArgumentsReportIndex", func(t *testing.T) {
+		mapper := New()
+		var nilFunc func(string) int
+
+		err := RegisterMany(mapper,
+			stringToInt,
+			"not a function",
+			func(a, b int) int { return a + b },
+			func(s string) {},
+			nil,
+			nilFunc,
+			func(s ...string) int { return len(s) },
+		)
+		if !errors.Is(err, ErrInvalidMappingFunc) {
+			t.Fatalf("Expected ErrInvalidMappingFunc, got %v", err)
+		}
+
+		for _, index := range []string{"index 1", "index 2", "index 3", "index 4", "index 5", "index 6"} {
+			if !strings.Contains(err.Error(), index) {
+				t.Errorf("Expected error to mention %s, got: %v", index, err)
+			}
+		}
+		if strings.Contains(err.Error(), "index 0") {
+			t.Errorf("Expected valid argument to not be reported, got: %v", err)
+		}
+	})
+
+	t.Run("InvalidArgumentRegistersNothing", func(t *testing.T) {
+		mapper := New()
+
+		err := RegisterMany(mapper, stringToInt, 42)
+		if err == nil {
+			t.Fatal("Expected error for invalid argument")
+		}
+		if Has[string, int](mapper) {
+			t.Error("Expected no mappings to be registered when an argument is invalid")
+		}
+	})
+}
+
+// TestRegisterProjection tests registering S -> []D projections
+func TestRegisterProjection(t *testing.T) {
+	type Team struct {
+		Name    string
+		Members []Person
+	}
+	type MemberDTO struct {
+		Name string
+	}
+
+	extractMembers := func(t Team) []Person { return t.Members }
+	toMember := func(p Person) MemberDTO { return MemberDTO{Name: p.Name} }
+
+	t.Run("ProjectMembersOutOfTeam", func(t *testing.T) {
+		mapper := New()
+		RegisterProjection(mapper, extractMembers, toMember)
+
+		team := Team{Name: "Core", Members: []Person{{Name: "Alice"}, {Name: "Bob"}}}
+		got, err := Map[Team, []MemberDTO](mapper, team)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []MemberDTO{{Name: "Alice"}, {Name: "Bob"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("ProjectFromPointerSource", func(t *testing.T) {
+		mapper := New()
+		RegisterProjection(mapper, extractMembers, toMember)
+
+		team := &Team{Members: []Person{{Name: "Alice"}}}
+		got, err := Map[*Team, []MemberDTO](mapper, team)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 1 || got[0].Name != "Alice" {
+			t.Errorf("Expected [{Alice}], got %v", got)
+		}
+	})
+
+	t.Run("NilMembersProjectToNil", func(t *testing.T) {
+		mapper := New()
+		RegisterProjection(mapper, extractMembers, toMember)
+
+		got, err := Map[Team, []MemberDTO](mapper, Team{Name: "Empty"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != nil {
+			t.Errorf("Expected nil slice, got %v", got)
+		}
+	})
+
+	t.Run("RegisteredUnderSliceDestination", func(t *testing.T) {
+		mapper := New()
+		RegisterProjection(mapper, extractMembers, toMember)
+
+		if !Has[Team, []MemberDTO](mapper) {
+			t.Error("Expected Team->[]MemberDTO mapping to be registered")
+		}
+		if Has[Team, MemberDTO](mapper) {
+			t.Error("Expected Team->MemberDTO mapping to not exist")
+		}
+	})
+}
+
+// TestRegisterPair tests registering forward and reverse mappings together
+func TestRegisterPair(t *testing.T) {
+	dtoToPerson := func(d PersonDTO) Person { return Person{Name: d.FullName, Age: d.Years} }
+
+	t.Run("MapForwardAndBack", func(t *testing.T) {
+		mapper := New()
+		RegisterPair(mapper, personToDTO, dtoToPerson)
+
+		person := Person{Name: "John", Age: 30}
+		dto, err := Map[Person, PersonDTO](mapper, person)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto.FullName != "John" || dto.Years != 30 {
+			t.Errorf("Expected {John 30}, got %+v", dto)
+		}
+
+		back, err := Map[PersonDTO, Person](mapper, dto)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if back != person {
+			t.Errorf("Expected %+v, got %+v", person, back)
+		}
+	})
+
+	t.Run("RegistersBothDirections", func(t *testing.T) {
+		mapper := New()
+		RegisterPair(mapper, stringToInt, intToString)
+
+		if !Has[string, int](mapper) || !Has[int, string](mapper) {
+			t.Errorf("Expected both directions to be registered, got %v", List(mapper))
+		}
+		if len(List(mapper)) != 2 {
+			t.Errorf("Expected 2 mappings, got %v", List(mapper))
+		}
+	})
+}
+
+// TestRegisterHelpersRejectNil tests that registration helpers fail fast on nil functions
+func TestRegisterHelpersRejectNil(t *testing.T) {
+	expectNilPanic := func(t *testing.T, register func()) {
+		t.Helper()
+		defer func() {
+			err, ok := recover().(error)
+			if !ok || !errors.Is(err, ErrNilMappingFunc) {
+				t.Errorf("Expected panic with ErrNilMappingFunc, got %v", err)
+			}
+		}()
+		register()
+	}
+
+	t.Run("RegisterPairNilReverse", func(t *testing.T) {
+		mapper := New()
+		expectNilPanic(t, func() { RegisterPair[string, int](mapper, stringToInt, nil) })
+	})
+
+	t.Run("RegisterProjectionNilExtract", func(t *testing.T) {
+		mapper := New()
+		expectNilPanic(t, func() { RegisterProjection[Person, string, int](mapper, nil, stringToInt) })
+		if Has[Person, []int](mapper) {
+			t.Error("Expected projection to not be registered")
+		}
+	})
+
+	t.Run("RegisterProjectionNilElementMapper", func(t *testing.T) {
+		mapper := New()
+		extract := func(p Person) []string { return []string{p.Name} }
+		expectNilPanic(t, func() { RegisterProjection[Person, string, int](mapper, extract, nil) })
+	})
+
+	t.Run("RegisterENil", func(t *testing.T) {
+		mapper := New()
+		expectNilPanic(t, func() { RegisterE[string, int](mapper, nil) })
+	})
+
+	t.Run("RegisterManyNilReturnsError", func(t *testing.T) {
+		mapper := New()
+		var fn func(string) int
+
+		err := RegisterMany(mapper, fn)
+		if !errors.Is(err, ErrNilMappingFunc) {
+			t.Errorf("Expected ErrNilMappingFunc, got %v", err)
+		}
+		if !errors.Is(err, ErrInvalidMappingFunc) {
+			t.Errorf("Expected ErrInvalidMappingFunc, got %v", err)
+		}
+	})
+}
+
+// TestRegisterWithArg tests registering mapping functions with a curried argument
+func TestRegisterWithArg(t *testing.T) {
+	multiply := func(factor int, v int) int { return v * factor }
+
+	t.Run("CurriesArgument", func(t *testing.T) {
+		mapper := New()
+		RegisterWithArg(mapper, 3, func(factor int, s string) int { return len(s) * factor })
+
+		result, err := Map[string, int](mapper, "hello")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result != 15 {
+			t.Errorf("Expected 15, got %d", result)
+		}
+	})
+
+	t.Run("ArgumentCapturedAtRegistration", func(t *testing.T) {
+		mapper := New()
+		factor := 2
+		RegisterWithArg(mapper, factor, multiply)
+		factor = 10
+
+		result, err := Map[int, int](mapper, 21)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result != 42 {
+			t.Errorf("Expected 42, got %d", result)
+		}
+	})
+
+	t.Run("WorksWithMapSlice", func(t *testing.T) {
+		mapper := New()
+		RegisterWithArg(mapper, 10, multiply)
+
+		result, err := MapSlice[[]int, []int](mapper, []int{1, 2, 3})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(result, []int{10, 20, 30}) {
+			t.Errorf("Expected [10 20 30], got %v", result)
+		}
+	})
+
+	t.Run("NilFunctionPanics", func(t *testing.T) {
+		mapper := New()
+
+		defer func() {
+			err, ok := recover().(error)
+			if !ok || !errors.Is(err, ErrNilMappingFunc) {
+				t.Errorf("Expected panic with ErrNilMappingFunc, got %v", err)
+			}
+		}()
+
+		RegisterWithArg[int, int, int](mapper, 2, nil)
+	})
+}
+
+// TestRegisterConvertible tests registering mappings backed by Go conversions
+func TestRegisterConvertible(t *testing.T) {
+	type Celsius float64
+	type UserID string
+
+	t.Run("NamedToUnderlying", func(t *testing.T) {
+		mapper := New()
+		if err := RegisterConvertible[Celsius, float64](mapper); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		got, err := Map[Celsius, float64](mapper, 21.5)
+		if err != nil || got != 21.5 {
+			t.Errorf("Expected 21.5, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("UnderlyingToNamed", func(t *testing.T) {
+		mapper := New()
+		if err := RegisterConvertible[string, UserID](mapper); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		got, err := Map[string, UserID](mapper, "u-42")
+		if err != nil || got != UserID("u-42") {
+			t.Errorf("Expected u-42, got %v, %v", got, err)
+		}
+
+		ptr, err := Map[*string, *UserID](mapper, nil)
+		if err != nil || ptr != nil {
+			t.Errorf("Expected nil for nil source, got %v, %v", ptr, err)
+		}
+	})
+
+	t.Run("BothDirectionsAndSlices", func(t *testing.T) {
+		mapper := New()
+		if err := RegisterConvertible[Celsius, float64](mapper); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if err := RegisterConvertible[float64, Celsius](mapper); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		got, err := MapSlice[[]float64, []Celsius](mapper, []float64{-5, 30})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := []Celsius{-5, 30}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("NotConvertible", func(t *testing.T) {
+		mapper := New()
+		if err := RegisterConvertible[Person, string](mapper); !errors.Is(err, ErrNotConvertible) {
+			t.Errorf("Expected ErrNotConvertible, got %v", err)
+		}
+		if Has[Person, string](mapper) {
+			t.Error("Expected nothing to be registered")
+		}
+	})
+
+	t.Run("ValueNotConvertible", func(t *testing.T) {
+		mapper := New()
+		if err := RegisterConvertible[[]int, [2]int](mapper); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if _, err := Map[[]int, [2]int](mapper, []int{1}); !errors.Is(err, ErrNotConvertible) {
+			t.Errorf("Expected ErrNotConvertible, got %v", err)
+		}
+		got, err := Map[[]int, [2]int](mapper, []int{1, 2})
+		if err != nil || got != [2]int{1, 2} {
+			t.Errorf("Expected [1 2], got %v, %v", got, err)
+		}
+	})
+}
+
+// cents is a fixed-precision amount of money, standing in for a decimal type in tests
+type cents int64
+
+// TestRegisterE tests registering mapping functions that can fail
+func TestRegisterE(t *testing.T) {
+	errFormat := errors.New("invalid amount")
+	mapper := New()
+	RegisterE(mapper, func(s string) (cents, error) {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", errFormat, s)
+		}
+		return cents(math.Round(f * 100)), nil
+	})
+	RegisterE(mapper, func(f float64) (cents, error) {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, errFormat
+		}
+		return cents(math.Round(f * 100)), nil
+	})
+
+	t.Run("ConvertsWithRounding", func(t *testing.T) {
+		tests := []struct {
+			src  string
+			want cents
+		}{
+			{"19.99", 1999},
+			{"0.005", 1},
+			{"-2.345", -235},
+		}
+		for _, tt := range tests {
+			got, err := Map[string, cents](mapper, tt.src)
+			if err != nil || got != tt.want {
+				t.Errorf("Expected %d for %q, got %d, %v", tt.want, tt.src, got, err)
+			}
+		}
+		if got, _ := Map[float64, cents](mapper, 1.257); got != 126 {
+			t.Errorf("Expected 126, got %d", got)
+		}
+	})
+
+	t.Run("ReturnsError", func(t *testing.T) {
+		if _, err := Map[string, cents](mapper, "twelve"); !errors.Is(err, errFormat) {
+			t.Errorf("Expected invalid amount error, got %v", err)
+		}
+		if _, err := Map[float64, cents](mapper, math.NaN()); err != errFormat {
+			t.Errorf("Expected the error unwrapped, got %v", err)
+		}
+	})
+
+	t.Run("SliceHelpersReturnError", func(t *testing.T) {
+		_, err := MapSlice[[]string, []cents](mapper, []string{"1", "x"})
+		if !errors.Is(err, errFormat) {
+			t.Errorf("Expected invalid amount error, got %v", err)
+		}
+	})
+}
+
+// TestBoxUnbox tests converting mapping functions to and from their stored form
+func TestBoxUnbox(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		fn, ok := Unbox[string, int](Box(stringToInt))
+		if !ok {
+			t.Fatal("Expected boxed function to unbox")
+		}
+		if got := fn("hello"); got != 5 {
+			t.Errorf("Expected 5, got %d", got)
+		}
+	})
+
+	t.Run("MatchesRegistryForm", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		stored, _ := mapper.registry.get(keyOf[string, int]())
+		if _, ok := Unbox[string, int](stored); !ok {
+			t.Errorf("Expected the stored function to unbox, got %T", stored)
+		}
+		if reflect.TypeOf(stored) != reflect.TypeOf(Box(stringToInt)) {
+			t.Errorf("Expected stored type %T, got %T", Box(stringToInt), stored)
+		}
+	})
+
+	t.Run("BulkInsert", func(t *testing.T) {
+		mapper := New()
+		table := []any{Box(stringToInt), Box(personToDTO)}
+
+		if err := RegisterMany(mapper, table...); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto, err := Map[Person, PersonDTO](mapper, Person{Name: "Ann"}); err != nil || dto.FullName != "Ann" {
+			t.Errorf("Expected {Ann 0}, got %v, %v", dto, err)
+		}
+	})
+
+	t.Run("MismatchedTypes", func(t *testing.T) {
+		if _, ok := Unbox[int, string](Box(stringToInt)); ok {
+			t.Error("Expected func(string) int not to unbox as func(int) string")
+		}
+		if _, ok := Unbox[*Person, PersonDTO](Box(personToDTO)); ok {
+			t.Error("Expected func(Person) PersonDTO not to unbox as func(*Person) PersonDTO")
+		}
+		if _, ok := Unbox[string, int]("not a function"); ok {
+			t.Error("Expected a string not to unbox")
+		}
+	})
+
+	t.Run("NilFunction", func(t *testing.T) {
+		var fn func(string) int
+		if _, ok := Unbox[string, int](Box(fn)); ok {
+			t.Error("Expected a nil function not to unbox")
+		}
+		if err := RegisterMany(New(), Box(fn)); !errors.Is(err, ErrNilMappingFunc) {
+			t.Errorf("Expected ErrNilMappingFunc, got %v", err)
+		}
+	})
+}
+
+// TestRegisterIdentity tests the identity mapping and its slice copy fast path
+func TestRegisterIdentity(t *testing.T) {
+	type IDs []int
+
+	t.Run("MapsValuesUnchanged", func(t *testing.T) {
+		mapper := New()
+		RegisterIdentity[Person](mapper)
+
+		got, err := Map[Person, *Person](mapper, Person{Name: "Ann", Age: 30})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got == nil || *got != (Person{Name: "Ann", Age: 30}) {
+			t.Errorf("Expected &{Ann 30}, got %v", got)
+		}
+	})
+
+	t.Run("CopiesSlices", func(t *testing.T) {
+		mapper := New()
+		RegisterIdentity[int](mapper)
+
+		src := []int{1, 2, 3}
+		got, err := MapSlice[[]int, IDs](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		src[0] = 99
+		if !reflect.DeepEqual(got, IDs{1, 2, 3}) {
+			t.Errorf("Expected an unaliased copy [1 2 3], got %v", got)
+		}
+
+		empty, err := MapSlice[[]int, []int](mapper, []int{})
+		if err != nil || empty == nil || len(empty) != 0 {
+			t.Errorf("Expected an empty non-nil slice, got %#v (err: %v)", empty, err)
+		}
+		nilSlice, err := MapSlice[[]int, []int](mapper, nil)
+		if err != nil || nilSlice != nil {
+			t.Errorf("Expected a nil slice, got %#v (err: %v)", nilSlice, err)
+		}
+	})
+
+	t.Run("PointerSlicesGetFreshPointers", func(t *testing.T) {
+		mapper := New()
+		RegisterIdentity[Person](mapper)
+
+		src := []*Person{{Name: "Ann"}, nil}
+		got, err := MapSlice[[]*Person, []*Person](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got[0] == src[0] || got[0].Name != "Ann" || got[1] != nil {
+			t.Errorf("Expected a fresh copy of Ann and nil, got %v", got)
+		}
+	})
+
+	t.Run("EquivalentFunctionStillCalled", func(t *testing.T) {
+		mapper := New()
+		calls := 0
+		Register(mapper, func(v int) int { calls++; return v })
+
+		if _, err := MapSlice[[]int, []int](mapper, []int{1, 2, 3}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("Expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("ReplacedIdentityIsCalled", func(t *testing.T) {
+		mapper := New()
+		RegisterIdentity[int](mapper)
+		calls := 0
+		Register(mapper, func(v int) int { calls++; return v * 2 })
+
+		got, err := MapSlice[[]int, []int](mapper, []int{1, 2, 3})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if calls != 3 || !reflect.DeepEqual(got, []int{2, 4, 6}) {
+			t.Errorf("Expected 3 calls and [2 4 6], got %d calls and %v", calls, got)
+		}
+	})
+
+	t.Run("PostProcessorsRun", func(t *testing.T) {
+		mapper := New()
+		RegisterIdentity[int](mapper)
+		RegisterPostProcess(mapper, func(v *int) { *v *= 10 })
+
+		got, err := MapSlice[[]int, []int](mapper, []int{1, 2})
+		if err != nil || !reflect.DeepEqual(got, []int{10, 20}) {
+			t.Errorf("Expected [10 20], got %v (err: %v)", got, err)
+		}
+	})
+}
@@ -0,0 +1,312 @@
+package mapper
+
+import (
+	"maps"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// registry stores the mapping functions of a Mapper by type pair. The current state is
+// immutable and published through an atomic pointer, so lookups never lock; writers clone
+// it under mu, apply their change to the clone, and swap it in. This favors the usual
+// pattern of registering at startup and mapping millions of times afterwards.
+type registry struct {
+	mu    sync.Mutex
+	state atomic.Pointer[registryState]
+}
+
+// registryState is one published version of a registry. kinds holds the kind of every
+// entry that is not a plain registered function; it is published together with entries
+// so that the two always agree, and a kind never outlives its entry. dynamic caches the
+// lookups of dynamicMatch for this version, so it is discarded with the version on the
+// next change.
+type registryState struct {
+	entries map[typePair]any
+	kinds   map[typePair]entryKind
+	dynamic sync.Map // dynamicKey -> dynamicMapping
+}
+
+// entryKind records how a registry entry was registered, where its function needs
+// different handling from one passed to Register.
+type entryKind uint8
+
+const (
+	// manualEntry is a function passed to Register or a similar function. It is the
+	// zero value and is not stored in kinds.
+	manualEntry entryKind = iota
+
+	// autoEntry is a function generated by the RegisterAutoMap family.
+	autoEntry
+
+	// fallibleEntry is a function that can fail with failMapping, such as one wrapped by
+	// RegisterE, so that a MapHandle knows to recover from its calls.
+	fallibleEntry
+
+	// identityEntry is the function registered by RegisterIdentity, which slice mapping
+	// replaces with a plain copy.
+	identityEntry
+)
+
+// canFail reports whether functions of kind k can fail with failMapping.
+func (k entryKind) canFail() bool {
+	return k == autoEntry || k == fallibleEntry
+}
+
+// dynamicKey identifies a dynamicMatch lookup: the dynamic type of an interface value and
+// the interface type of the field it is stored in.
+type dynamicKey struct {
+	value, iface reflect.Type
+}
+
+// dynamicMapping is the mapping chosen for an interface value by dynamicMatch, with the
+// type its result is stored as. fn is nil if there is not exactly one such mapping.
+type dynamicMapping struct {
+	fn     any
+	target reflect.Type
+}
+
+// newRegistry returns an empty registry.
+func newRegistry() *registry {
+	r := &registry{}
+	r.state.Store(&registryState{
+		entries: make(map[typePair]any),
+		kinds:   make(map[typePair]entryKind),
+	})
+	return r
+}
+
+// get returns the mapping function registered for key.
+func (r *registry) get(key typePair) (any, bool) {
+	fn, ok := r.state.Load().entries[key]
+	return fn, ok
+}
+
+// lookup returns the mapping function registered for key along with its kind, read from
+// the same version of the registry.
+func (r *registry) lookup(key typePair) (any, entryKind, bool) {
+	state := r.state.Load()
+	fn, ok := state.entries[key]
+	return fn, state.kinds[key], ok
+}
+
+// dynamicMatch returns the mapping for an interface value of type value stored in a field
+// of type iface, as described for mapDynamic. Results are cached per registry version, so
+// only the first lookup of a pair scans the entries.
+func (r *registry) dynamicMatch(value, iface reflect.Type) dynamicMapping {
+	state := r.state.Load()
+	key := dynamicKey{value: value, iface: iface}
+	if cached, ok := state.dynamic.Load(key); ok {
+		return cached.(dynamicMapping)
+	}
+	match := findDynamic(state.entries, value, iface)
+	state.dynamic.Store(key, match)
+	return match
+}
+
+// snapshot returns the current entries. The map must not be modified.
+func (r *registry) snapshot() map[typePair]any {
+	return r.state.Load().entries
+}
+
+// update applies change to a copy of the entries and their kinds and publishes the copy,
+// so that several entries can be changed in one swap. A change that replaces an entry
+// must also set or clear its kind.
+func (r *registry) update(change func(entries map[typePair]any, kinds map[typePair]entryKind)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.state.Load()
+	next := &registryState{
+		entries: maps.Clone(current.entries),
+		kinds:   maps.Clone(current.kinds),
+	}
+	change(next.entries, next.kinds)
+	r.state.Store(next)
+}
+
+// set registers fn under key as a manual mapping.
+func (r *registry) set(key typePair, fn any) {
+	r.setKind(key, fn, manualEntry)
+}
+
+// setKind registers fn under key as a mapping of the given kind.
+func (r *registry) setKind(key typePair, fn any, kind entryKind) {
+	r.update(func(entries map[typePair]any, kinds map[typePair]entryKind) {
+		entries[key] = fn
+		setKind(kinds, key, kind)
+	})
+}
+
+// setKind records kind for key in kinds, leaving manual entries out of the map.
+func setKind(kinds map[typePair]entryKind, key typePair, kind entryKind) {
+	if kind == manualEntry {
+		delete(kinds, key)
+	} else {
+		kinds[key] = kind
+	}
+}
+
+// reset removes every mapping function.
+func (r *registry) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state.Store(&registryState{
+		entries: make(map[typePair]any),
+		kinds:   make(map[typePair]entryKind),
+	})
+}
+
+// delete removes the mapping function registered under key.
+func (r *registry) delete(key typePair) {
+	r.update(func(entries map[typePair]any, kinds map[typePair]entryKind) {
+		delete(entries, key)
+		delete(kinds, key)
+	})
+}
+
+// mapEntryOverhead is the estimated bookkeeping cost per map entry, such as the top hash
+// byte, bucket slack at the usual load factor and overflow pointers.
+const mapEntryOverhead = 16
+
+// Sizes of the registry's keys and values, measured once.
+var (
+	typePairSize = reflect.TypeOf(typePair{}).Size()
+	anySize      = reflect.TypeOf((*any)(nil)).Elem().Size()
+)
+
+// RegistryMemStats is a rough estimate of the memory held by a mapper's registry.
+type RegistryMemStats struct {
+	// Entries is the number of registered mapping functions.
+	Entries int
+
+	// AutoMapped is the number of entries generated by the RegisterAutoMap family. Their
+	// kind is tracked in a second map, along with that of other special entries such as
+	// RegisterE's.
+	AutoMapped int
+
+	// EstimatedBytes estimates the bytes held by the registry maps: keys, boxed function
+	// values and a fixed per-entry overhead. Closures captured by the functions, such as
+	// auto-map field plans, and the reflect type data the keys point to are not counted.
+	EstimatedBytes uint64
+}
+
+// MemStats estimates the memory footprint of the mapper's registry. It helps capacity
+// planning for services with thousands of registrations, such as code-generated ones, and
+// spotting registries that grow at run time. The figure is an approximation built from the
+// sizes of the keys and values; it is meant for comparison across mappers and over time,
+// not as an exact heap measurement.
+//
+// Parameters:
+//   - m: The mapper instance to inspect
+//
+// Returns:
+//   - RegistryMemStats: The entry counts and the estimated bytes
+//
+// Example:
+//
+//	stats := MemStats(mapper)
+//	log.Printf("%d mappings, ~%d KiB", stats.Entries, stats.EstimatedBytes/1024)
+func MemStats(m Mapper) RegistryMemStats {
+	state := m.registry.state.Load()
+	stats := RegistryMemStats{Entries: len(state.entries)}
+	for _, kind := range state.kinds {
+		if kind == autoEntry {
+			stats.AutoMapped++
+		}
+	}
+
+	entryBytes := uint64(typePairSize + anySize + mapEntryOverhead)
+	kindBytes := uint64(typePairSize + 1 + mapEntryOverhead)
+	stats.EstimatedBytes = uint64(stats.Entries)*entryBytes + uint64(len(state.kinds))*kindBytes
+	for _, fn := range state.entries {
+		// The interface points to the boxed function value, usually one word
+		if t := reflect.TypeOf(fn); t != nil {
+			stats.EstimatedBytes += uint64(t.Size())
+		}
+	}
+	return stats
+}
+
+// DestinationsFor returns every destination type that has a registered mapping from src.
+// It is useful for capability negotiation, such as offering "convert this object to X/Y/Z"
+// in a dynamic API. The result is sorted by type name so it can be displayed directly.
+//
+// Parameters:
+//   - m: The mapper instance to inspect
+//   - src: The source type; a pointer type is resolved to its element type
+//
+// Returns:
+//   - []reflect.Type: The destination types registered for src (empty if none, or if
+//     src is nil). Like
+//     registry keys, they never include the outer pointer of the registered function.
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Person) PersonDTO { return PersonDTO{Name: p.Name} })
+//	Register(mapper, func(p Person) string { return p.Name })
+//
+//	for _, dst := range DestinationsFor(mapper, reflect.TypeOf(Person{})) {
+//	    fmt.Println(dst)
+//	}
+//	// Output:
+//	// main.PersonDTO
+//	// string
+func DestinationsFor(m Mapper, src reflect.Type) []reflect.Type {
+	if src == nil {
+		return nil
+	}
+	src = derefType(src)
+	var types []reflect.Type
+	for k := range m.registry.snapshot() {
+		if k.src == src {
+			types = append(types, k.dst)
+		}
+	}
+	sortTypes(types)
+	return types
+}
+
+// SourcesFor returns every source type that has a registered mapping to dst.
+// It is the reverse of DestinationsFor and is sorted by type name as well.
+//
+// Parameters:
+//   - m: The mapper instance to inspect
+//   - dst: The destination type; a pointer type is resolved to its element type
+//
+// Returns:
+//   - []reflect.Type: The source types registered for dst (empty if none, or if dst is
+//     nil), without the outer pointer of the registered function
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Person) PersonDTO { return PersonDTO{Name: p.Name} })
+//	Register(mapper, func(e Employee) PersonDTO { return PersonDTO{Name: e.Name} })
+//
+//	sources := SourcesFor(mapper, reflect.TypeOf(PersonDTO{}))
+//	fmt.Println(len(sources)) // Output: 2
+func SourcesFor(m Mapper, dst reflect.Type) []reflect.Type {
+	if dst == nil {
+		return nil
+	}
+	dst = derefType(dst)
+	var types []reflect.Type
+	for k := range m.registry.snapshot() {
+		if k.dst == dst {
+			types = append(types, k.src)
+		}
+	}
+	sortTypes(types)
+	return types
+}
+
+// sortTypes orders types by their string representation.
+func sortTypes(types []reflect.Type) {
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].String() < types[j].String()
+	})
+}
@@ -0,0 +1,230 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestRegistryConcurrency tests registering and removing mappings while other goroutines map
+func TestRegistryConcurrency(t *testing.T) {
+	t.Run("RegisterWhileMapping", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					dto, err := Map[Person, PersonDTO](mapper, Person{Name: "Alice"})
+					if err != nil || dto.FullName != "Alice" {
+						t.Errorf("Expected Alice, got %+v (err: %v)", dto, err)
+						return
+					}
+				}
+			}()
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				Register(mapper, stringToInt)
+				Remove[string, int](mapper)
+			}
+		}()
+		wg.Wait()
+
+		if Has[string, int](mapper) {
+			t.Error("Expected string->int to be removed")
+		}
+	})
+
+	t.Run("SnapshotIsUnaffectedByLaterWrites", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		before := mapper.registry.snapshot()
+		Register(mapper, stringToInt)
+		Remove[Person, PersonDTO](mapper)
+
+		if len(before) != 1 {
+			t.Errorf("Expected snapshot to keep 1 entry, got %d", len(before))
+		}
+		if _, ok := before[keyOf[Person, PersonDTO]()]; !ok {
+			t.Error("Expected snapshot to keep Person->PersonDTO")
+		}
+	})
+}
+
+// TestDestinationsFor tests looking up destinations registered for a source type
+func TestDestinationsFor(t *testing.T) {
+	t.Run("SeveralMappingsShareSource", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+		Register(mapper, func(s string) bool { return s != "" })
+		Register(mapper, func(s string) PersonDTO { return PersonDTO{FullName: s} })
+		Register(mapper, intToString)
+
+		got := DestinationsFor(mapper, reflect.TypeOf(""))
+		want := []reflect.Type{
+			reflect.TypeOf(false),
+			reflect.TypeOf(0),
+			reflect.TypeOf(PersonDTO{}),
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("UnknownSourceReturnsEmpty", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		if got := DestinationsFor(mapper, reflect.TypeOf(Person{})); len(got) != 0 {
+			t.Errorf("Expected no destinations, got %v", got)
+		}
+	})
+}
+
+// TestSourcesFor tests looking up sources registered for a destination type
+func TestSourcesFor(t *testing.T) {
+	t.Run("SeveralMappingsShareDestination", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, intToString)
+		Register(mapper, func(b bool) string { return "bool" })
+		Register(mapper, func(p Person) string { return p.Name })
+		Register(mapper, stringToInt)
+
+		got := SourcesFor(mapper, reflect.TypeOf(""))
+		want := []reflect.Type{
+			reflect.TypeOf(false),
+			reflect.TypeOf(0),
+			reflect.TypeOf(Person{}),
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("UnknownDestinationReturnsEmpty", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		if got := SourcesFor(mapper, reflect.TypeOf(PersonDTO{})); len(got) != 0 {
+			t.Errorf("Expected no sources, got %v", got)
+		}
+	})
+}
+
+// TestUnusualKeyTypes tests that dynamic APIs report errors for func, map and nil types
+// instead of panicking while building registry keys
+func TestUnusualKeyTypes(t *testing.T) {
+	t.Run("FuncAndMapDestinationsWithoutMapping", func(t *testing.T) {
+		mapper := New()
+
+		if _, err := Map[Person, func() int](mapper, Person{}); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if _, err := Map[any, map[string]int](mapper, Person{}); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if _, err := MapSlice[[]Person, []func()](mapper, []Person{{}}); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("FuncAndMapDestinationsAutoMapped", func(t *testing.T) {
+		mapper := New()
+
+		if _, err := MapWith[Person, func()](mapper, Person{}, WithCaseInsensitive()); !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported, got %v", err)
+		}
+		if _, err := MapToStruct[map[string]int](mapper, nil); !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported, got %v", err)
+		}
+		if _, err := StructToMap[func()](mapper, nil); !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported, got %v", err)
+		}
+	})
+
+	t.Run("FuncAndMapTypesAreValidKeys", func(t *testing.T) {
+		mapper := New()
+		err := RegisterMany(mapper,
+			func(p Person) func() string { return func() string { return p.Name } },
+			func(m map[string]int) int { return len(m) },
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		fn, err := Map[Person, func() string](mapper, Person{Name: "Alice"})
+		if err != nil || fn() != "Alice" {
+			t.Errorf("Expected func returning Alice, got %v", err)
+		}
+		n, err := Map[map[string]int, int](mapper, map[string]int{"a": 1, "b": 2})
+		if err != nil || n != 2 {
+			t.Errorf("Expected 2, got %d, %v", n, err)
+		}
+	})
+
+	t.Run("NilTypes", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		if got := DestinationsFor(mapper, nil); got != nil {
+			t.Errorf("Expected no destinations, got %v", got)
+		}
+		if got := SourcesFor(mapper, nil); got != nil {
+			t.Errorf("Expected no sources, got %v", got)
+		}
+		if _, err := Map[any, PersonDTO](mapper, nil); !errors.Is(err, ErrUntypedNil) {
+			t.Errorf("Expected ErrUntypedNil, got %v", err)
+		}
+	})
+}
+
+// TestMemStats tests the registry memory estimate
+func TestMemStats(t *testing.T) {
+	t.Run("EmptyRegistry", func(t *testing.T) {
+		if stats := MemStats(New()); stats != (RegistryMemStats{}) {
+			t.Errorf("Expected zero stats, got %+v", stats)
+		}
+	})
+
+	t.Run("CountsEntries", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		Register(mapper, stringToInt)
+		RegisterAutoMap[NestedStruct, Person](mapper)
+
+		stats := MemStats(mapper)
+		if stats.Entries != len(List(mapper)) || stats.Entries != 4 {
+			t.Errorf("Expected 4 entries, got %d", stats.Entries)
+		}
+		if stats.AutoMapped != 2 {
+			t.Errorf("Expected 2 auto-mapped entries, got %d", stats.AutoMapped)
+		}
+	})
+
+	t.Run("ScalesWithEntries", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		one := MemStats(mapper).EstimatedBytes
+
+		Register(mapper, stringToInt)
+		Register(mapper, intToString)
+		three := MemStats(mapper).EstimatedBytes
+		if one == 0 || three != 3*one {
+			t.Errorf("Expected three manual entries to cost 3x one (%d), got %d", one, three)
+		}
+
+		Remove[string, int](mapper)
+		if two := MemStats(mapper).EstimatedBytes; two != 2*one {
+			t.Errorf("Expected the estimate to shrink to %d after Remove, got %d", 2*one, two)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	"reflect"
+)
+
+// SetResolver installs a function that Map consults to obtain a mapping on demand when
+// none is registered for the requested type pair. It suits plugin systems and code
+// generation, where not every mapping is known when the mapper is built.
+//
+// The resolver receives the source and destination types with one level of pointer
+// indirection stripped, exactly as mappings are keyed by Register. It returns a mapping
+// function in any of the forms Register accepts for that pair, such as
+// func(Person) PersonDTO or func(*Person) *PersonDTO, and true; or nil and false when it
+// has no mapping, in which case Map goes on to the fallback, if any, and otherwise
+// returns ErrNoMapping. A resolved function is stored in the registry, so the resolver is
+// asked at most once per pair and later calls, including MapSlice and the other helpers,
+// find the mapping directly. A function whose signature does not fit the pair is not
+// stored, and Map returns an error wrapping ErrSignatureMismatch.
+//
+// The resolver may be called concurrently from several goroutines, and more than once for
+// the same pair if they miss at the same time. Passing a nil function removes a
+// previously installed resolver; mappings it already supplied stay registered.
+//
+// Parameters:
+//   - m: The mapper instance to install the resolver on
+//   - fn: The resolver function, or nil to remove it
+//
+// Example:
+//
+//	mapper := New()
+//	SetResolver(mapper, func(src, dst reflect.Type) (any, bool) {
+//	    return plugins.Lookup(src, dst) // e.g. func(plugin.Event) EventDTO
+//	})
+//
+//	dto, err := Map[plugin.Event, EventDTO](mapper, event) // resolved, then cached
+func SetResolver(m Mapper, fn func(src, dst reflect.Type) (any, bool)) {
+	m.config.resolver = fn
+}
+
+// resolve asks the mapper's resolver for a mapping for key and registers it. It reports
+// false without an error if there is no resolver or it has no mapping for key.
+func resolve(m Mapper, key typePair) (any, bool, error) {
+	if m.config.resolver == nil {
+		return nil, false, nil
+	}
+	fn, ok := m.config.resolver(key.src, key.dst)
+	if !ok || fn == nil {
+		return nil, false, nil
+	}
+	if err := checkSignature(reflect.TypeOf(fn), key.src, key.dst); err != nil {
+		return nil, false, err
+	}
+	if reflect.ValueOf(fn).IsNil() {
+		return nil, false, nil
+	}
+	m.registry.set(key, fn)
+	return fn, true, nil
+}
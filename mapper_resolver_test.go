@@ -0,0 +1,87 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestSetResolver tests resolving mappings on demand
+func TestSetResolver(t *testing.T) {
+	t.Run("ResolvesAndCachesUnregisteredPair", func(t *testing.T) {
+		mapper := New()
+		var asked []string
+		SetResolver(mapper, func(src, dst reflect.Type) (any, bool) {
+			asked = append(asked, src.String()+"->"+dst.String())
+			if src == reflect.TypeOf(Person{}) && dst == reflect.TypeOf(PersonDTO{}) {
+				return personToDTO, true
+			}
+			return nil, false
+		})
+
+		for i := 0; i < 2; i++ {
+			dto, err := Map[*Person, PersonDTO](mapper, &Person{Name: "Ann", Age: 30})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if dto.FullName != "Ann" || dto.Years != 30 {
+				t.Errorf("Expected {Ann 30}, got %v", dto)
+			}
+		}
+		if len(asked) != 1 || asked[0] != "mapper.Person->mapper.PersonDTO" {
+			t.Errorf("Expected one resolver call for Person->PersonDTO, got %v", asked)
+		}
+		if !Has[Person, PersonDTO](mapper) {
+			t.Error("Expected resolved mapping to be registered")
+		}
+
+		dtos, err := MapSlice[[]Person, []PersonDTO](mapper, []Person{{Name: "Bob"}})
+		if err != nil || len(dtos) != 1 || dtos[0].FullName != "Bob" {
+			t.Errorf("Expected [{Bob 0}], got %v (err: %v)", dtos, err)
+		}
+	})
+
+	t.Run("NoMappingFromResolver", func(t *testing.T) {
+		mapper := New()
+		SetResolver(mapper, func(src, dst reflect.Type) (any, bool) { return nil, false })
+
+		if _, err := Map[string, int](mapper, "x"); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+
+		SetFallback(mapper, func(src any, dstType reflect.Type) (any, error) { return 7, nil })
+		if n, err := Map[string, int](mapper, "x"); err != nil || n != 7 {
+			t.Errorf("Expected fallback result 7, got %d (err: %v)", n, err)
+		}
+	})
+
+	t.Run("WrongSignatureNotCached", func(t *testing.T) {
+		mapper := New()
+		SetResolver(mapper, func(src, dst reflect.Type) (any, bool) { return intToString, true })
+
+		if _, err := Map[string, int](mapper, "x"); !errors.Is(err, ErrSignatureMismatch) {
+			t.Errorf("Expected ErrSignatureMismatch, got %v", err)
+		}
+		if Has[string, int](mapper) {
+			t.Error("Expected mismatched mapping not to be registered")
+		}
+	})
+
+	t.Run("RegisteredMappingWins", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+		SetResolver(mapper, func(src, dst reflect.Type) (any, bool) {
+			t.Error("Expected resolver not to be called for a registered pair")
+			return nil, false
+		})
+
+		if n, err := Map[string, int](mapper, "hello"); err != nil || n != 5 {
+			t.Errorf("Expected 5, got %d (err: %v)", n, err)
+		}
+
+		SetResolver(mapper, nil)
+		if _, err := Map[int, bool](mapper, 1); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping after removing resolver, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,285 @@
+package mapper
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrRowField is returned, wrapped, by StructToRow and RowToStruct for a struct field whose
+// type cannot be stored in a row cell, or whose tag gives an invalid column.
+var ErrRowField = errors.New("field cannot be stored in a row")
+
+// ErrRowLength is returned, wrapped, by RowToStruct when the row does not have one cell per
+// column of the struct.
+var ErrRowLength = errors.New("row length does not match struct columns")
+
+// textMarshalerType and textUnmarshalerType are the reflect.Types of the encoding text
+// interfaces.
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// rowColumn is one cell of a row: the struct field at index, named by its field path.
+type rowColumn struct {
+	index []int
+	name  string
+}
+
+// StructToRow converts a struct into a row of strings, one cell per exported field. It
+// bridges structs to CSV encoders and other positional formats, such as
+// csv.Writer.Write(row).
+//
+// Columns follow the declaration order of the fields, including fields promoted from
+// embedded structs. When tag is not empty, the struct tag with that key controls the
+// columns: a field tagged "-" is left out, and a field tagged with a non-negative integer,
+// such as `csv:"0"`, is placed at that position. Fields with an integer position come first,
+// in position order, followed by the others in declaration order; any other tag value,
+// such as a header name, is ignored. Options after a comma are ignored as well.
+//
+// Cells are formatted with strconv: booleans as true or false, integers in base 10 and
+// floats in the shortest form that parses back to the same value. Strings are stored as-is
+// and types implementing encoding.TextMarshaler, such as time.Time, through MarshalText.
+//
+// Type Parameters:
+//   - S: Source type; a struct or a pointer to a struct
+//
+// Parameters:
+//   - src: The struct to convert
+//   - tag: The struct tag key controlling the columns, or "" to use every field
+//
+// Returns:
+//   - []string: The cells in column order, or nil if src is a nil pointer
+//   - error: An error wrapping ErrAutoMapUnsupported if S is not a struct or a pointer to a
+//     struct, or wrapping ErrRowField for a field of another kind, such as a slice, or an
+//     invalid position tag
+//
+// Example:
+//
+//	type Record struct {
+//	    Name  string  `csv:"1"`
+//	    ID    int     `csv:"0"`
+//	    Score float64
+//	    Notes string  `csv:"-"`
+//	}
+//
+//	row, _ := StructToRow(Record{ID: 7, Name: "John", Score: 9.5}, "csv")
+//	fmt.Println(row) // Output: [7 John 9.5]
+func StructToRow[S any](src S, tag string) ([]string, error) {
+	srcType := typeOf[S]()
+	columns, err := rowColumns(srcType, tag, textMarshalerType)
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.ValueOf(&src).Elem()
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		cell, err := formatCell(v.FieldByIndex(c.index))
+		if err != nil {
+			return nil, fmt.Errorf("column %d (%s): %w", i, c.name, err)
+		}
+		row[i] = cell
+	}
+	return row, nil
+}
+
+// RowToStruct converts a row of strings into a struct, the reverse of StructToRow with
+// the same tag. Columns are laid out as StructToRow describes, and the row must have
+// exactly one cell per column.
+//
+// Cells are parsed with strconv according to the field kind, so integers must be in base
+// 10 and fit the field's size. An empty cell leaves a non-string field at its zero value,
+// as CSV exports often leave blanks. Types whose pointer implements
+// encoding.TextUnmarshaler, such as time.Time, are parsed with UnmarshalText.
+//
+// Type Parameters:
+//   - D: Destination type; a struct or a pointer to a struct, which is allocated
+//
+// Parameters:
+//   - row: The cells in column order
+//   - tag: The struct tag key controlling the columns, or "" to use every field
+//
+// Returns:
+//   - D: The parsed struct
+//   - error: An error wrapping ErrAutoMapUnsupported if D is not a struct or a pointer to a
+//     struct, ErrRowField for a field that cannot be stored in a row, ErrRowLength for a
+//     row of the wrong length, or the parse error of a cell, naming its column
+//
+// Example:
+//
+//	record, err := RowToStruct[Record]([]string{"7", "John", "9.5"}, "csv")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(record.Name) // Output: John
+func RowToStruct[D any](row []string, tag string) (D, error) {
+	var dst D
+	dstType := typeOf[D]()
+	columns, err := rowColumns(dstType, tag, textUnmarshalerType)
+	if err != nil {
+		return dst, err
+	}
+	if len(row) != len(columns) {
+		return dst, fmt.Errorf("%w: %s has %d columns, row has %d cells", ErrRowLength, derefType(dstType), len(columns), len(row))
+	}
+
+	v := reflect.ValueOf(&dst).Elem()
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(dstType.Elem()))
+		v = v.Elem()
+	}
+	for i, c := range columns {
+		if err := parseCell(v.FieldByIndex(c.index), row[i]); err != nil {
+			var zero D
+			return zero, fmt.Errorf("column %d (%s): %w", i, c.name, err)
+		}
+	}
+	return dst, nil
+}
+
+// rowColumns returns the columns of the struct t, or the struct t points to, under tag.
+// Fields are checked to be storable in a cell, with text types recognized by textIface.
+func rowColumns(t reflect.Type, tag string, textIface reflect.Type) ([]rowColumn, error) {
+	st := derefType(t)
+	if st.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s", ErrAutoMapUnsupported, t)
+	}
+
+	type positioned struct {
+		rowColumn
+		pos int
+	}
+	var placed []positioned
+	var rest []rowColumn
+	seen := make(map[int]string)
+	for _, f := range mappableFields(st) {
+		var value string
+		if tag != "" {
+			value, _, _ = strings.Cut(f.Tag.Get(tag), ",")
+		}
+		if value == "-" {
+			continue
+		}
+		name := fieldPath(st, f.Index)
+		if !cellType(f.Type, textIface) {
+			return nil, fmt.Errorf("%w: %s.%s is %s", ErrRowField, st, name, f.Type)
+		}
+
+		c := rowColumn{index: f.Index, name: name}
+		pos, err := strconv.Atoi(value)
+		if err != nil {
+			rest = append(rest, c)
+			continue
+		}
+		if pos < 0 {
+			return nil, fmt.Errorf("%w: %s.%s has negative position %d", ErrRowField, st, name, pos)
+		}
+		if other, dup := seen[pos]; dup {
+			return nil, fmt.Errorf("%w: %s.%s and %s.%s both have position %d", ErrRowField, st, other, st, name, pos)
+		}
+		seen[pos] = name
+		placed = append(placed, positioned{rowColumn: c, pos: pos})
+	}
+
+	sort.Slice(placed, func(i, j int) bool { return placed[i].pos < placed[j].pos })
+	columns := make([]rowColumn, 0, len(placed)+len(rest))
+	for _, p := range placed {
+		columns = append(columns, p.rowColumn)
+	}
+	return append(columns, rest...), nil
+}
+
+// cellType reports whether values of t can be stored in a cell: basic kinds and types
+// whose pointer implements textIface.
+func cellType(t reflect.Type, textIface reflect.Type) bool {
+	if reflect.PointerTo(t).Implements(textIface) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// formatCell formats the field value v as a cell.
+func formatCell(v reflect.Value) (string, error) {
+	if v.CanAddr() && v.Addr().Type().Implements(textMarshalerType) {
+		text, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+}
+
+// parseCell parses cell into the settable field v.
+func parseCell(v reflect.Value, cell string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		if cell == "" {
+			return nil
+		}
+		return u.UnmarshalText([]byte(cell))
+	}
+	if v.Kind() == reflect.String {
+		v.SetString(cell)
+		return nil
+	}
+	if cell == "" {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(cell, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(cell, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	default:
+		f, err := strconv.ParseFloat(cell, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestStructToRow tests converting structs to and from rows of strings
+func TestStructToRow(t *testing.T) {
+	type Level int8
+	type Base struct {
+		ID uint16
+	}
+	type Record struct {
+		Base
+		Name    string
+		Active  bool
+		Level   Level
+		Score   float64
+		Ratio   float32
+		Big     int64
+		Created time.Time
+	}
+	record := Record{
+		Base:    Base{ID: 7},
+		Name:    "John, Jr.",
+		Active:  true,
+		Level:   -3,
+		Score:   9.5,
+		Ratio:   0.1,
+		Big:     1 << 40,
+		Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	t.Run("DeclarationOrderRoundTrip", func(t *testing.T) {
+		row, err := StructToRow(record, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []string{"7", "John, Jr.", "true", "-3", "9.5", "0.1", "1099511627776", "2024-01-02T03:04:05Z"}
+		if !reflect.DeepEqual(row, want) {
+			t.Errorf("Expected %q, got %q", want, row)
+		}
+
+		back, err := RowToStruct[Record](row, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(back, record) {
+			t.Errorf("Expected %+v, got %+v", record, back)
+		}
+	})
+
+	t.Run("TagOrderAndExclusion", func(t *testing.T) {
+		type Tagged struct {
+			Name   string  `csv:"1"`
+			ID     int     `csv:"0,required"`
+			Score  float64 `csv:"score"`
+			Notes  string  `csv:"-"`
+			Hidden []int   `csv:"-"`
+		}
+
+		row, err := StructToRow(&Tagged{ID: 7, Name: "John", Score: 9.5, Notes: "x"}, "csv")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := []string{"7", "John", "9.5"}; !reflect.DeepEqual(row, want) {
+			t.Errorf("Expected %q, got %q", want, row)
+		}
+
+		back, err := RowToStruct[*Tagged](row, "csv")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := (Tagged{ID: 7, Name: "John", Score: 9.5}); back == nil || !reflect.DeepEqual(*back, want) {
+			t.Errorf("Expected %+v, got %+v", want, back)
+		}
+	})
+
+	t.Run("EmptyCellsAreZero", func(t *testing.T) {
+		got, err := RowToStruct[Record]([]string{"", "", "", "", "", "", "", ""}, "")
+		if err != nil || !reflect.DeepEqual(got, Record{}) {
+			t.Errorf("Expected a zero Record, got %+v (err: %v)", got, err)
+		}
+	})
+
+	t.Run("NilPointer", func(t *testing.T) {
+		row, err := StructToRow[*Record](nil, "")
+		if err != nil || row != nil {
+			t.Errorf("Expected nil, nil, got %q, %v", row, err)
+		}
+	})
+
+	t.Run("UnsupportedFields", func(t *testing.T) {
+		type WithSlice struct {
+			Tags []string
+		}
+		if _, err := StructToRow(WithSlice{}, ""); !errors.Is(err, ErrRowField) {
+			t.Errorf("Expected ErrRowField for a slice field, got %v", err)
+		}
+		if _, err := RowToStruct[WithSlice]([]string{"a"}, ""); !errors.Is(err, ErrRowField) {
+			t.Errorf("Expected ErrRowField for a slice field, got %v", err)
+		}
+
+		type Duplicate struct {
+			A int `csv:"0"`
+			B int `csv:"0"`
+		}
+		if _, err := StructToRow(Duplicate{}, "csv"); !errors.Is(err, ErrRowField) {
+			t.Errorf("Expected ErrRowField for duplicate positions, got %v", err)
+		}
+
+		if _, err := StructToRow(42, ""); !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported for an int, got %v", err)
+		}
+	})
+
+	t.Run("InvalidRows", func(t *testing.T) {
+		_, err := RowToStruct[Record]([]string{"1"}, "")
+		if !errors.Is(err, ErrRowLength) {
+			t.Errorf("Expected ErrRowLength, got %v", err)
+		}
+
+		row, _ := StructToRow(record, "")
+		row[3] = "300"
+		_, err = RowToStruct[Record](row, "")
+		if err == nil || !strings.HasPrefix(err.Error(), "column 3 (Level): ") {
+			t.Errorf("Expected an out-of-range error for column 3, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,656 @@
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// MapToSlice maps a single source value and wraps the result in a one-element slice.
+// It is useful when an API exposes a single value but the consumer expects a collection.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source value to be mapped
+//
+// Returns:
+//   - []D: A slice containing exactly one mapped element
+//   - error: ErrNoMapping if no mapping function is registered for the type pair
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(t Tag) TagDTO { return TagDTO{Name: t.Name} })
+//	tags, err := MapToSlice[Tag, TagDTO](mapper, Tag{Name: "go"})
+//	fmt.Println(len(tags)) // Output: 1
+func MapToSlice[S any, D any](m Mapper, src S) ([]D, error) {
+	result, err := Map[S, D](m, src)
+	if err != nil {
+		return nil, err
+	}
+	return []D{result}, nil
+}
+
+// MapFirst maps the first element of a source slice to the destination type.
+// It is the counterpart of MapToSlice for APIs that expose a collection where
+// the consumer only expects a single value.
+//
+// Type Parameters:
+//   - S: Source element type
+//   - D: Destination type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice whose first element is mapped
+//
+// Returns:
+//   - D: The mapped first element
+//   - error: ErrEmptySlice if src is nil or empty, or ErrNoMapping if no mapping
+//     function is registered for the type pair
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(t Tag) TagDTO { return TagDTO{Name: t.Name} })
+//	tag, err := MapFirst[Tag, TagDTO](mapper, []Tag{{Name: "go"}, {Name: "rust"}})
+//	fmt.Println(tag.Name) // Output: go
+//
+//	_, err = MapFirst[Tag, TagDTO](mapper, nil)
+//	fmt.Println(err) // Output: source slice is empty
+func MapFirst[S any, D any](m Mapper, src []S) (D, error) {
+	if len(src) == 0 {
+		var dst D
+		return dst, ErrEmptySlice
+	}
+	return Map[S, D](m, src[0])
+}
+
+// MapElems maps each element of src to D and returns the results in a new slice. It is
+// MapSlice for plain []S and []D slices, named by their element types only, so the slice
+// types need not be spelled out and the source and result are typed as slices. Use
+// MapSlice when the slice types are named types, such as type People []Person.
+//
+// When the registered function has exactly the signature func(S) D and S is not a pointer
+// type, elements are mapped by a direct call without reflection. Otherwise MapElems behaves
+// exactly like MapSlice, including for pointer elements and nil pointers.
+//
+// Type Parameters:
+//   - S: Source element type
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice to be mapped
+//
+// Returns:
+//   - []D: A new slice containing the mapped elements; nil if src is nil
+//   - error: ErrNoMapping if no mapping function is registered for the element types
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Person) PersonDTO { return PersonDTO{FullName: p.Name} })
+//
+//	dtos, err := MapElems[Person, PersonDTO](mapper, people)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func MapElems[S any, D any](m Mapper, src []S) (_ []D, err error) {
+	fn, ok := m.registry.get(keyOf[S, D]())
+	if direct, fast := fn.(func(S) D); ok && fast && typeOf[S]().Kind() != reflect.Ptr {
+		if st := m.config.stats; st != nil {
+			st.sliceHits.Add(1)
+		}
+		if src == nil {
+			return nil, nil
+		}
+
+		defer recoverFailure(&err)
+		dst := make([]D, len(src))
+		for i, v := range src {
+			dst[i] = postProcess(m, v, direct(v))
+		}
+		return dst, nil
+	}
+
+	result, err := mapSliceValue(m, reflect.ValueOf(src), typeOf[[]D]())
+	if err != nil {
+		return nil, err
+	}
+	return result.Interface().([]D), nil
+}
+
+// MapSliceAppend maps each element of src and appends the results to dst, returning the
+// extended slice like the built-in append. It accumulates several batches into one slice
+// without allocating and copying an intermediate slice per batch; dst is grown once per
+// call, in place when it has enough capacity.
+//
+// As with append, the result may share its backing array with dst, so use the returned
+// slice rather than dst afterwards. Elements are mapped with the same pointer and value
+// handling as MapSlice.
+//
+// Type Parameters:
+//   - S: Source slice type (e.g., []SourceType, []*SourceType)
+//   - D: Destination slice type (e.g., []DestType, []*DestType)
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice to be mapped; a nil or empty slice returns dst unchanged
+//   - dst: The slice to append to; may be nil
+//
+// Returns:
+//   - D: dst with the mapped elements of src appended
+//   - error: ErrSrcAndDestMustBeSlices if src or dst is not a slice, or ErrNoMapping if no
+//     mapping function is registered for the element types, in which case dst is returned
+//     unchanged
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Person) PersonDTO { return PersonDTO{FullName: p.Name} })
+//
+//	var all []PersonDTO
+//	for _, page := range pages {
+//	    all, err = MapSliceAppend(mapper, page, all)
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+func MapSliceAppend[S any, D any](m Mapper, src S, dst D) (_ D, err error) {
+	srcType := reflect.TypeOf(src)
+	dstType := typeOf[D]()
+	if !isSlice(srcType) || !isSlice(dstType) {
+		return dst, &SliceTypeError{Func: "MapSliceAppend", Src: srcType, Dst: dstType}
+	}
+
+	key := pairOf(srcType.Elem(), dstType.Elem())
+	fn, ok := m.registry.get(key)
+	if !ok {
+		if st := m.config.stats; st != nil {
+			st.misses.Add(1)
+		}
+		return dst, ErrNoMapping
+	}
+	if st := m.config.stats; st != nil {
+		st.sliceHits.Add(1)
+	}
+
+	srcValue := reflect.ValueOf(src)
+	n := srcValue.Len()
+	if n == 0 {
+		return dst, nil
+	}
+
+	defer recoverSignatureMismatch(srcType.Elem(), dstType.Elem(), &err)
+
+	out := reflect.New(dstType).Elem()
+	out.Set(reflect.ValueOf(dst))
+	start := out.Len()
+	out.Grow(n)
+	out.SetLen(start + n)
+	fillSlice(fn, srcValue, out.Slice(start, start+n), m.config.nilDefaults[key])
+	postProcessSlice(m, srcValue, out.Slice(start, start+n))
+	return out.Interface().(D), nil
+}
+
+// MapSliceFlatten maps the elements of a slice of slices, such as [][]Point, and
+// concatenates the results into a single flat []D in order. It suits grouped data, such as
+// pages or batches, that the consumer wants as one list.
+//
+// The result is allocated once for the total number of elements. Nil and empty inner
+// slices contribute nothing. Elements are mapped with the same pointer and value handling
+// as MapSlice.
+//
+// Type Parameters:
+//   - S: Source slice-of-slices type (e.g., [][]SourceType, [][]*SourceType)
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The slice of slices to be mapped and flattened
+//
+// Returns:
+//   - []D: The mapped elements of all inner slices; nil if src is nil, and empty but
+//     non-nil if src is empty or holds only empty inner slices
+//   - error: ErrSrcAndDestMustBeSlices if src is not a slice of slices, or ErrNoMapping if
+//     no mapping function is registered for the inner element type and D
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Point) PointDTO { return PointDTO{X: p.X, Y: p.Y} })
+//
+//	points, err := MapSliceFlatten[[][]Point, PointDTO](mapper, [][]Point{{{1, 2}}, nil, {{3, 4}}})
+//	fmt.Println(len(points)) // Output: 2
+func MapSliceFlatten[S any, D any](m Mapper, src S) (_ []D, err error) {
+	srcType := reflect.TypeOf(src)
+	if !isSlice(srcType) {
+		return nil, &SliceTypeError{Func: "MapSliceFlatten", Src: srcType}
+	}
+	if !isSlice(srcType.Elem()) {
+		return nil, fmt.Errorf("%w: MapSliceFlatten: source elements are %s, want slice", ErrSrcAndDestMustBeSlices, srcType.Elem().Kind())
+	}
+
+	elemType, dstType := srcType.Elem().Elem(), typeOf[D]()
+	key := pairOf(elemType, dstType)
+	fn, ok := m.registry.get(key)
+	if !ok {
+		if st := m.config.stats; st != nil {
+			st.misses.Add(1)
+		}
+		return nil, ErrNoMapping
+	}
+	if st := m.config.stats; st != nil {
+		st.sliceHits.Add(1)
+	}
+
+	srcValue := reflect.ValueOf(src)
+	if srcValue.IsNil() {
+		return nil, nil
+	}
+
+	defer recoverSignatureMismatch(elemType, dstType, &err)
+
+	total := 0
+	for i := 0; i < srcValue.Len(); i++ {
+		total += srcValue.Index(i).Len()
+	}
+
+	dst := make([]D, total)
+	out := reflect.ValueOf(dst)
+	offset := 0
+	for i := 0; i < srcValue.Len(); i++ {
+		inner := srcValue.Index(i)
+		n := inner.Len()
+		fillSlice(fn, inner, out.Slice(offset, offset+n), m.config.nilDefaults[key])
+		postProcessSlice(m, inner, out.Slice(offset, offset+n))
+		offset += n
+	}
+	return dst, nil
+}
+
+// MapSliceIndexed maps each element of src with fn, passing the element's index, and
+// returns the results in a new slice. It does not use a mapper and suits one-off mappings
+// that depend on position, such as numbering rows.
+//
+// Type Parameters:
+//   - S: Source element type
+//   - D: Destination element type
+//
+// Parameters:
+//   - src: The source slice to be mapped
+//   - fn: Function mapping an element and its zero-based index
+//
+// Returns:
+//   - []D: The mapped elements; nil if src is nil
+//
+// Example:
+//
+//	rows := MapSliceIndexed(users, func(i int, u User) Row {
+//	    return Row{No: i + 1, Name: u.Name}
+//	})
+func MapSliceIndexed[S any, D any](src []S, fn func(int, S) D) []D {
+	if src == nil {
+		return nil
+	}
+
+	dst := make([]D, len(src))
+	for i, v := range src {
+		dst[i] = fn(i, v)
+	}
+	return dst
+}
+
+// MapSliceWithIndex maps each element of a source slice through the registry like
+// MapSlice, then passes each mapped element and its index to post, which returns the
+// final element. It covers mappings where only part of the result depends on position,
+// such as assigning a rank after the registered mapping.
+//
+// Type Parameters:
+//   - S: Source slice type (e.g., []SourceType, []*SourceType)
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice to be mapped
+//   - post: Function adjusting a mapped element given its zero-based index
+//
+// Returns:
+//   - []D: The adjusted elements; nil if src is nil
+//   - error: Any error returned by MapSlice, in which case post is not called
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Player) PlayerDTO { return PlayerDTO{Name: p.Name} })
+//
+//	ranked, err := MapSliceWithIndex(mapper, leaderboard, func(i int, p PlayerDTO) PlayerDTO {
+//	    p.Rank = i + 1
+//	    return p
+//	})
+func MapSliceWithIndex[S any, D any](m Mapper, src S, post func(int, D) D) ([]D, error) {
+	mapped, err := MapSlice[S, []D](m, src)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range mapped {
+		mapped[i] = post(i, v)
+	}
+	return mapped, nil
+}
+
+// MapSliceDedup maps each element of a source slice like MapSlice and drops duplicate
+// results, keeping the first occurrence of each value in its original position. It is
+// useful for building lookup lists, such as the distinct categories of a list of events.
+//
+// Duplicates are detected with a map[D]struct{} seen-set, so D must be comparable. For
+// pointer destination element types, values are compared by pointer identity rather than
+// by the data they point to; since every mapped element usually gets a fresh pointer, no
+// duplicates are removed in that case, which is rarely what you want. Use
+// MapSliceDedupFunc to compare such values, or non-comparable ones, by their contents.
+//
+// Type Parameters:
+//   - S: Source slice type (e.g., []SourceType, []*SourceType)
+//   - D: Destination element type; must be comparable
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice to be mapped
+//
+// Returns:
+//   - []D: The distinct mapped elements in first-seen order
+//   - error: Any error returned by MapSlice
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(e Event) Category { return e.Category })
+//
+//	events := []Event{{Category: "news"}, {Category: "sport"}, {Category: "news"}}
+//	categories, err := MapSliceDedup[[]Event, Category](mapper, events)
+//	fmt.Println(categories) // Output: [news sport]
+func MapSliceDedup[S any, D comparable](m Mapper, src S) ([]D, error) {
+	mapped, err := MapSlice[S, []D](m, src)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[D]struct{}, len(mapped))
+	dst := mapped[:0]
+	for _, v := range mapped {
+		if _, dup := seen[v]; dup {
+			continue
+		}
+		seen[v] = struct{}{}
+		dst = append(dst, v)
+	}
+	return dst, nil
+}
+
+// MapSliceDedupFunc is like MapSliceDedup, but detects duplicates with the eq predicate
+// instead of ==. It serves destination types that are not comparable, such as structs with
+// slice or map fields, and pointer destinations that should be compared by the data they
+// point to.
+//
+// Each mapped element is compared against every element kept so far, so deduplication
+// costs O(n²) calls to eq for n elements. Prefer MapSliceDedup, which runs in linear time,
+// whenever D is comparable and == is the intended equality.
+//
+// Type Parameters:
+//   - S: Source slice type (e.g., []SourceType, []*SourceType)
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice to be mapped
+//   - eq: Reports whether two mapped elements are duplicates of each other
+//
+// Returns:
+//   - []D: The distinct mapped elements in first-seen order
+//   - error: Any error returned by MapSlice
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(p Post) TagSet { return TagSet{Tags: p.Tags} })
+//
+//	sets, err := MapSliceDedupFunc[[]Post, TagSet](mapper, posts, func(a, b TagSet) bool {
+//	    return slices.Equal(a.Tags, b.Tags)
+//	})
+func MapSliceDedupFunc[S any, D any](m Mapper, src S, eq func(a, b D) bool) ([]D, error) {
+	mapped, err := MapSlice[S, []D](m, src)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := mapped[:0]
+	for _, v := range mapped {
+		if slices.ContainsFunc(dst, func(kept D) bool { return eq(kept, v) }) {
+			continue
+		}
+		dst = append(dst, v)
+	}
+	return dst, nil
+}
+
+// MapSliceCompact maps each element of a source slice like MapSlice, but drops nil pointer
+// elements instead of mapping them, so the result may be shorter than src. It suits
+// compact result lists where MapSlice would turn each nil *A into a zero B, or a nil *B.
+//
+// It only differs from MapSlice for source slices of pointers; for other element types it
+// is equivalent to MapSlice. Nil default values set with RegisterNilDefault are not used,
+// since nil elements are never mapped.
+//
+// Type Parameters:
+//   - S: Source slice type (e.g., []*SourceType)
+//   - D: Destination slice type (e.g., []DestType, []*DestType)
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice to be mapped
+//
+// Returns:
+//   - D: The mapped non-nil elements, in source order. A nil source slice maps to a nil
+//     destination slice, and a source slice without non-nil elements to an empty one.
+//   - error: Any error returned by MapSlice
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(u User) UserDTO { return UserDTO{Name: u.Name} })
+//
+//	users := []*User{{Name: "Ann"}, nil, {Name: "Bob"}}
+//	dtos, err := MapSliceCompact[[]*User, []UserDTO](mapper, users)
+//	fmt.Println(len(dtos)) // Output: 2
+func MapSliceCompact[S any, D any](m Mapper, src S) (D, error) {
+	srcType := reflect.TypeOf(src)
+	srcValue := reflect.ValueOf(src)
+	if !isSlice(srcType) || srcType.Elem().Kind() != reflect.Pointer || srcValue.IsNil() {
+		return MapSlice[S, D](m, src)
+	}
+
+	compact := reflect.MakeSlice(srcType, 0, srcValue.Len())
+	for i := 0; i < srcValue.Len(); i++ {
+		if elem := srcValue.Index(i); !elem.IsNil() {
+			compact = reflect.Append(compact, elem)
+		}
+	}
+	return MapSlice[S, D](m, compact.Interface().(S))
+}
+
+// GroupBy maps each element of src through the registry like MapElems and groups the
+// results by the key that key computes from the source element. It covers report-building
+// code such as collecting event DTOs per category. Within each group, mapped elements keep
+// the order of their source elements.
+//
+// Type Parameters:
+//   - S: Source element type
+//   - K: Group key type; must be comparable
+//   - D: Destination element type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice to be mapped
+//   - key: Function computing the group of a source element
+//
+// Returns:
+//   - map[K][]D: The mapped elements by group; nil if src is nil, empty if src is empty
+//   - error: Any error returned by MapElems, in which case key is not called
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(e Event) EventDTO { return EventDTO{Title: e.Title} })
+//
+//	byCategory, err := GroupBy[Event, Category, EventDTO](mapper, events,
+//	    func(e Event) Category { return e.Category })
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(len(byCategory["news"]))
+func GroupBy[S any, K comparable, D any](m Mapper, src []S, key func(S) K) (map[K][]D, error) {
+	mapped, err := MapElems[S, D](m, src)
+	if err != nil || mapped == nil {
+		return nil, err
+	}
+
+	groups := make(map[K][]D)
+	for i, v := range mapped {
+		k := key(src[i])
+		groups[k] = append(groups[k], v)
+	}
+	return groups, nil
+}
+
+// MapSliceReduce maps each element of a source slice and folds the results into an
+// accumulator, without allocating the intermediate destination slice. It suits
+// map-then-aggregate pipelines such as summing mapped prices or joining mapped names.
+//
+// Each element is mapped exactly as MapSlice maps it: the same pointer and value
+// handling, nil defaults from RegisterNilDefault, post-processing functions and stats.
+// Like MapSlice, the registered mapping is used and conditional mappings are not
+// consulted. Mapped elements are folded in order, starting from init. When the registered
+// function takes the source element type and returns D exactly, and no post-processing
+// function is registered for D, it is called directly for each element, without
+// reflection; other cases map each element through a reused one-element slice.
+//
+// Type Parameters:
+//   - S: Source slice type (e.g., []SourceType, []*SourceType)
+//   - D: Mapped element type passed to reduce
+//   - R: Accumulator type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source slice to be mapped
+//   - init: The initial accumulator value
+//   - reduce: Function combining the accumulator with each mapped element
+//
+// Returns:
+//   - R: The final accumulator value (init if src is empty)
+//   - error: ErrSrcAndDestMustBeSlices if src is not a slice, or ErrNoMapping if no mapping
+//     function is registered for the element types, checked before any element is mapped
+//
+// Example:
+//
+//	mapper := New()
+//	Register(mapper, func(i LineItem) int { return i.Price * i.Quantity })
+//
+//	total, err := MapSliceReduce(mapper, order.Items, 0, func(sum, price int) int {
+//	    return sum + price
+//	})
+func MapSliceReduce[S any, D any, R any](m Mapper, src S, init R, reduce func(R, D) R) (R, error) {
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Kind() != reflect.Slice {
+		return init, &SliceTypeError{Func: "MapSliceReduce", Src: reflect.TypeOf(src)}
+	}
+
+	dstType := typeOf[D]()
+	key := pairOf(srcValue.Type().Elem(), dstType)
+	fn, ok := m.registry.get(key)
+	if !ok {
+		if st := m.config.stats; st != nil {
+			st.misses.Add(1)
+		}
+		return init, ErrNoMapping
+	}
+	if st := m.config.stats; st != nil {
+		st.sliceHits.Add(1)
+	}
+
+	elemType := srcValue.Type().Elem()
+	if elemType.Kind() != reflect.Pointer && len(m.config.postProcess[derefType(dstType)]) == 0 {
+		// Non-pointer elements are never nil, so nil defaults do not apply either
+		if acc, ok, err := reduceDirect(fn, src, elemType, init, reduce); ok {
+			return acc, err
+		}
+	}
+
+	scratch := reflect.MakeSlice(reflect.SliceOf(dstType), 1, 1)
+	nilDefault := m.config.nilDefaults[key]
+	acc := init
+	for i := 0; i < srcValue.Len(); i++ {
+		if err := mapSliceElem(m, fn, srcValue.Slice(i, i+1), scratch, nilDefault); err != nil {
+			return init, err
+		}
+		acc = reduce(acc, valueAs[D](scratch.Index(0)))
+	}
+	return acc, nil
+}
+
+// sliceFolds maps the exact source and destination types of each function type registered
+// through registerKind to the foldSlice instantiation for them. It depends only on the
+// types, so it is shared by all mappers.
+var sliceFolds sync.Map // typePair -> func(fn, src any, each func(D)) bool
+
+// foldSlice calls each with fn(v) for every element v of src, if fn is a func(S) D and src
+// a []S, and reports whether it did. Nothing is called otherwise.
+func foldSlice[S any, D any](fn, src any, each func(D)) bool {
+	f, ok := fn.(func(S) D)
+	if !ok {
+		return false
+	}
+	elems, ok := src.([]S)
+	if !ok {
+		return false
+	}
+	for _, v := range elems {
+		each(f(v))
+	}
+	return true
+}
+
+// reduceDirect folds src like MapSliceReduce by calling fn directly with each element,
+// when fn is a func(E) D for the element type elemType of src. It reports false without
+// calling fn otherwise. A mapping failure stops the fold and returns init with the error.
+func reduceDirect[D any, R any](fn, src any, elemType reflect.Type, init R, reduce func(R, D) R) (acc R, ok bool, err error) {
+	fold, found := sliceFolds.Load(typePair{src: elemType, dst: typeOf[D]()})
+	if !found {
+		return init, false, nil
+	}
+
+	defer func() {
+		if err != nil {
+			acc, ok = init, true
+		}
+	}()
+	defer recoverSignatureMismatch(elemType, typeOf[D](), &err)
+
+	acc = init
+	ok = fold.(func(any, any, func(D)) bool)(fn, src, func(d D) { acc = reduce(acc, d) })
+	return acc, ok, nil
+}
+
+// mapSliceElem maps the one-element slice elem into the one-element slice scratch the way
+// mapSliceValue maps each element, including nil defaults and post-processing.
+func mapSliceElem(m Mapper, fn any, elem, scratch, nilDefault reflect.Value) (err error) {
+	defer recoverSignatureMismatch(elem.Type().Elem(), scratch.Type().Elem(), &err)
+	fillSlice(fn, elem, scratch, nilDefault)
+	postProcessSlice(m, elem, scratch)
+	return nil
+}
@@ -0,0 +1,773 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// TestMapToSlice tests wrapping a single mapped value in a slice
+func TestMapToSlice(t *testing.T) {
+	t.Run("WrapsMappedValue", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapToSlice[Person, PersonDTO](mapper, Person{Name: "John", Age: 30})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []PersonDTO{{FullName: "John", Years: 30}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("PointerDestination", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapToSlice[Person, *PersonDTO](mapper, Person{Name: "Jane", Age: 25})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 1 || got[0] == nil || got[0].FullName != "Jane" {
+			t.Errorf("Expected one pointer to {Jane 25}, got %v", got)
+		}
+	})
+
+	t.Run("UnregisteredReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		got, err := MapToSlice[Person, PersonDTO](mapper, Person{})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if got != nil {
+			t.Errorf("Expected nil slice on error, got %v", got)
+		}
+	})
+}
+
+// TestMapFirst tests mapping the first element of a slice
+func TestMapFirst(t *testing.T) {
+	t.Run("MapsFirstElement", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		src := []Person{{Name: "First", Age: 1}, {Name: "Second", Age: 2}}
+		got, err := MapFirst[Person, PersonDTO](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.FullName != "First" || got.Years != 1 {
+			t.Errorf("Expected {First 1}, got %+v", got)
+		}
+	})
+
+	t.Run("EmptySliceReturnsError", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		_, err := MapFirst[Person, PersonDTO](mapper, []Person{})
+		if !errors.Is(err, ErrEmptySlice) {
+			t.Errorf("Expected ErrEmptySlice, got %v", err)
+		}
+	})
+
+	t.Run("NilSliceReturnsError", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		_, err := MapFirst[Person, PersonDTO](mapper, nil)
+		if !errors.Is(err, ErrEmptySlice) {
+			t.Errorf("Expected ErrEmptySlice, got %v", err)
+		}
+	})
+
+	t.Run("UnregisteredReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapFirst[Person, PersonDTO](mapper, []Person{{Name: "John"}})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+}
+
+// TestMapElems tests mapping slices named by their element types
+func TestMapElems(t *testing.T) {
+	t.Run("DirectFunction", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapElems[Person, PersonDTO](mapper, []Person{{Name: "Alice", Age: 20}, {Name: "Bob", Age: 30}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []PersonDTO{{"Alice", 20}, {"Bob", 30}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("PointerElements", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapElems[*Person, *PersonDTO](mapper, []*Person{{Name: "Alice"}, nil})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 2 || got[0] == nil || got[0].FullName != "Alice" || got[1] != nil {
+			t.Errorf("Expected [&{Alice 0} <nil>], got %v", got)
+		}
+	})
+
+	t.Run("NilAndEmptySource", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapElems[Person, PersonDTO](mapper, nil)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil for nil source, got %v (err: %v)", got, err)
+		}
+
+		got, err = MapElems[Person, PersonDTO](mapper, []Person{})
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("Expected empty non-nil slice, got %v (err: %v)", got, err)
+		}
+	})
+
+	t.Run("MatchesMapSlice", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(p *Person) PersonDTO { return PersonDTO{FullName: p.Name} })
+
+		src := []Person{{Name: "Alice"}}
+		got, err := MapElems[Person, PersonDTO](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want, _ := MapSlice[[]Person, []PersonDTO](mapper, src)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("UnregisteredReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapElems[Person, PersonDTO](mapper, []Person{{Name: "Alice"}})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+}
+
+// TestMapSliceAppend tests appending mapped elements to an existing slice
+func TestMapSliceAppend(t *testing.T) {
+	t.Run("TwoBatches", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		var all []PersonDTO
+		all, err := MapSliceAppend(mapper, []Person{{Name: "Alice", Age: 20}, {Name: "Bob", Age: 30}}, all)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		all, err = MapSliceAppend(mapper, []Person{{Name: "Carol", Age: 40}}, all)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		want := []PersonDTO{{"Alice", 20}, {"Bob", 30}, {"Carol", 40}}
+		if !reflect.DeepEqual(all, want) {
+			t.Errorf("Expected %v, got %v", want, all)
+		}
+	})
+
+	t.Run("UsesSpareCapacity", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		dst := make([]PersonDTO, 1, 4)
+		dst[0] = PersonDTO{FullName: "First"}
+		got, err := MapSliceAppend(mapper, []Person{{Name: "Alice"}}, dst)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 2 || got[0].FullName != "First" || got[1].FullName != "Alice" {
+			t.Errorf("Expected [First Alice], got %v", got)
+		}
+		if &got[0] != &dst[0] {
+			t.Error("Expected the result to reuse dst's backing array")
+		}
+	})
+
+	t.Run("PointerElements", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapSliceAppend(mapper, []*Person{{Name: "Alice"}, nil}, []*PersonDTO{{FullName: "First"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 3 || got[1] == nil || got[1].FullName != "Alice" || got[2] != nil {
+			t.Errorf("Expected [First Alice <nil>], got %v", got)
+		}
+	})
+
+	t.Run("EmptySourceReturnsDst", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		dst := []PersonDTO{{FullName: "First"}}
+		got, err := MapSliceAppend[[]Person](mapper, nil, dst)
+		if err != nil || !reflect.DeepEqual(got, dst) {
+			t.Errorf("Expected %v, got %v (err: %v)", dst, got, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mapper := New()
+
+		dst := []PersonDTO{{FullName: "First"}}
+		got, err := MapSliceAppend(mapper, []Person{{Name: "Alice"}}, dst)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if len(got) != 1 {
+			t.Errorf("Expected dst unchanged, got %v", got)
+		}
+
+		if _, err := MapSliceAppend(mapper, Person{}, dst); !errors.Is(err, ErrSrcAndDestMustBeSlices) {
+			t.Errorf("Expected ErrSrcAndDestMustBeSlices, got %v", err)
+		}
+	})
+}
+
+// TestMapSliceFlatten tests mapping a slice of slices into one flat slice
+func TestMapSliceFlatten(t *testing.T) {
+	type Point struct{ X, Y int }
+	type PointDTO struct{ X, Y int }
+	toDTO := func(p Point) PointDTO { return PointDTO{X: p.X * 10, Y: p.Y * 10} }
+
+	t.Run("FlattensInOrder", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toDTO)
+
+		got, err := MapSliceFlatten[[][]Point, PointDTO](mapper, [][]Point{{{1, 2}, {3, 4}}, nil, {}, {{5, 6}}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []PointDTO{{10, 20}, {30, 40}, {50, 60}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("PointerElements", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toDTO)
+
+		got, err := MapSliceFlatten[[][]*Point, *PointDTO](mapper, [][]*Point{{{X: 1}}, {nil}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 2 || got[0] == nil || got[0].X != 10 || got[1] != nil {
+			t.Errorf("Expected [&{10 0} <nil>], got %v", got)
+		}
+	})
+
+	t.Run("EmptyInput", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toDTO)
+
+		got, err := MapSliceFlatten[[][]Point, PointDTO](mapper, nil)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil for nil source, got %v (err: %v)", got, err)
+		}
+
+		got, err = MapSliceFlatten[[][]Point, PointDTO](mapper, [][]Point{nil, {}})
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("Expected empty non-nil slice, got %v (err: %v)", got, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mapper := New()
+
+		if _, err := MapSliceFlatten[[][]Point, PointDTO](mapper, [][]Point{{{1, 2}}}); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if _, err := MapSliceFlatten[[]Point, PointDTO](mapper, []Point{{1, 2}}); !errors.Is(err, ErrSrcAndDestMustBeSlices) {
+			t.Errorf("Expected ErrSrcAndDestMustBeSlices, got %v", err)
+		}
+	})
+}
+
+// TestMapSliceIndexed tests mapping slices with access to each element's index
+func TestMapSliceIndexed(t *testing.T) {
+	type Ranked struct {
+		Rank int
+		Name string
+	}
+
+	t.Run("RegistryFree", func(t *testing.T) {
+		got := MapSliceIndexed([]string{"gold", "silver"}, func(i int, s string) Ranked {
+			return Ranked{Rank: i + 1, Name: s}
+		})
+		want := []Ranked{{1, "gold"}, {2, "silver"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+
+		if got := MapSliceIndexed[string, Ranked](nil, nil); got != nil {
+			t.Errorf("Expected nil for nil source, got %v", got)
+		}
+	})
+
+	t.Run("RegistryBackedRank", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(p Person) Ranked { return Ranked{Name: p.Name} })
+
+		got, err := MapSliceWithIndex(mapper, []Person{{Name: "Alice"}, {Name: "Bob"}, {Name: "Carol"}},
+			func(i int, r Ranked) Ranked {
+				r.Rank = i + 1
+				return r
+			})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []Ranked{{1, "Alice"}, {2, "Bob"}, {3, "Carol"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("RegistryBackedErrors", func(t *testing.T) {
+		called := false
+		post := func(i int, r Ranked) Ranked { called = true; return r }
+
+		_, err := MapSliceWithIndex(New(), []Person{{Name: "Alice"}}, post)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if called {
+			t.Error("Expected post not to be called on error")
+		}
+	})
+}
+
+// TestMapSliceDedup tests mapping a slice while dropping duplicate results
+func TestMapSliceDedup(t *testing.T) {
+	type Event struct {
+		Name     string
+		Category string
+	}
+	toCategory := func(e Event) string { return e.Category }
+
+	t.Run("KeepsFirstSeenOrder", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toCategory)
+
+		events := []Event{
+			{Name: "a", Category: "news"},
+			{Name: "b", Category: "sport"},
+			{Name: "c", Category: "news"},
+			{Name: "d", Category: "tech"},
+			{Name: "e", Category: "sport"},
+		}
+		got, err := MapSliceDedup[[]Event, string](mapper, events)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []string{"news", "sport", "tech"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("PointerSourceElements", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toCategory)
+
+		events := []*Event{{Category: "news"}, {Category: "news"}}
+		got, err := MapSliceDedup[[]*Event, string](mapper, events)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, []string{"news"}) {
+			t.Errorf("Expected [news], got %v", got)
+		}
+	})
+
+	t.Run("PointerDestinationsComparedByIdentity", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toCategory)
+
+		got, err := MapSliceDedup[[]Event, *string](mapper, []Event{{Category: "news"}, {Category: "news"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 2 {
+			t.Errorf("Expected 2 distinct pointers, got %d", len(got))
+		}
+	})
+
+	t.Run("UnregisteredReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapSliceDedup[[]Event, string](mapper, []Event{{Category: "news"}})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+}
+
+// TestMapSliceDedupFunc tests deduplicating mapped elements with an equality predicate
+func TestMapSliceDedupFunc(t *testing.T) {
+	type Post struct {
+		Title string
+		Tags  []string
+	}
+	type TagSet struct {
+		Tags []string
+	}
+	toTagSet := func(p Post) TagSet { return TagSet{Tags: p.Tags} }
+	sameTags := func(a, b TagSet) bool { return slices.Equal(a.Tags, b.Tags) }
+
+	t.Run("KeepsFirstSeenOrder", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toTagSet)
+
+		posts := []Post{
+			{Title: "a", Tags: []string{"go", "news"}},
+			{Title: "b", Tags: []string{"sport"}},
+			{Title: "c", Tags: []string{"go", "news"}},
+			{Title: "d"},
+			{Title: "e", Tags: []string{"sport"}},
+		}
+		got, err := MapSliceDedupFunc[[]Post, TagSet](mapper, posts, sameTags)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []TagSet{{Tags: []string{"go", "news"}}, {Tags: []string{"sport"}}, {}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("PointerDestinationsComparedByContents", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, toTagSet)
+
+		posts := []Post{{Tags: []string{"go"}}, {Tags: []string{"go"}}}
+		got, err := MapSliceDedupFunc[[]Post, *TagSet](mapper, posts, func(a, b *TagSet) bool {
+			return sameTags(*a, *b)
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(got) != 1 || !slices.Equal(got[0].Tags, []string{"go"}) {
+			t.Errorf("Expected one tag set [go], got %v", got)
+		}
+	})
+
+	t.Run("UnregisteredReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapSliceDedupFunc[[]Post, TagSet](mapper, []Post{{}}, sameTags)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+}
+
+// TestMapSliceReduce tests folding mapped elements into an accumulator
+func TestMapSliceReduce(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+
+	t.Run("SumsMappedIntegers", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		total, err := MapSliceReduce(mapper, []string{"a", "bb", "ccc"}, 0, sum)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if total != 6 {
+			t.Errorf("Expected 6, got %d", total)
+		}
+	})
+
+	t.Run("FoldsInOrder", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		people := []*Person{{Name: "Alice"}, nil, {Name: "Bob"}}
+		names, err := MapSliceReduce(mapper, people, "", func(acc string, dto PersonDTO) string {
+			return acc + "[" + dto.FullName + "]"
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if names != "[Alice][][Bob]" {
+			t.Errorf("Expected [Alice][][Bob], got %q", names)
+		}
+	})
+
+	t.Run("MatchesMapSlice", func(t *testing.T) {
+		mapper := New(WithStats())
+		Register(mapper, personToDTO)
+		RegisterNilDefault[Person](mapper, PersonDTO{FullName: "unknown"})
+		RegisterPostProcess(mapper, func(dto *PersonDTO) { dto.Years++ })
+
+		people := []*Person{{Name: "Alice", Age: 30}, nil, {Name: "Bob", Age: 40}}
+		folded, err := MapSliceReduce(mapper, people, []PersonDTO(nil), func(acc []PersonDTO, dto PersonDTO) []PersonDTO {
+			return append(acc, dto)
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		mapped, err := MapSlice[[]*Person, []PersonDTO](mapper, people)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(folded, mapped) {
+			t.Errorf("Expected %v like MapSlice, got %v", mapped, folded)
+		}
+		if got := Stats(mapper).SliceHits; got != 2 {
+			t.Errorf("Expected 2 slice hits, got %d", got)
+		}
+	})
+
+	t.Run("FailingElementReturnsInitAndError", func(t *testing.T) {
+		errEmpty := errors.New("empty")
+		mapper := New()
+		RegisterE(mapper, func(s string) (int, error) {
+			if s == "" {
+				return 0, errEmpty
+			}
+			return len(s), nil
+		})
+
+		total, err := MapSliceReduce(mapper, []string{"a", "", "ccc"}, 1, sum)
+		if !errors.Is(err, errEmpty) {
+			t.Errorf("Expected the element mapping error, got %v", err)
+		}
+		if total != 1 {
+			t.Errorf("Expected init to be returned, got %d", total)
+		}
+	})
+
+	t.Run("NamedSliceAndPostProcessing", func(t *testing.T) {
+		type Words []string
+
+		mapper := New()
+		Register(mapper, stringToInt)
+		total, err := MapSliceReduce(mapper, Words{"a", "bb"}, 0, sum)
+		if err != nil || total != 3 {
+			t.Errorf("Expected 3 for a named slice type, got %d (err: %v)", total, err)
+		}
+
+		RegisterPostProcess(mapper, func(v *int) { *v *= 10 })
+		total, err = MapSliceReduce(mapper, []string{"a", "bb"}, 0, sum)
+		if err != nil || total != 30 {
+			t.Errorf("Expected post-processed elements to sum to 30, got %d (err: %v)", total, err)
+		}
+	})
+
+	t.Run("EmptySliceReturnsInit", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		total, err := MapSliceReduce(mapper, []string(nil), 42, sum)
+		if err != nil || total != 42 {
+			t.Errorf("Expected 42, got %d (err: %v)", total, err)
+		}
+	})
+
+	t.Run("UnregisteredReturnsErrorUpFront", func(t *testing.T) {
+		mapper := New()
+		calls := 0
+
+		total, err := MapSliceReduce(mapper, []string{"a"}, 1, func(acc, v int) int {
+			calls++
+			return acc + v
+		})
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if calls != 0 || total != 1 {
+			t.Errorf("Expected reduce not to run and init to be returned, got %d calls and %d", calls, total)
+		}
+	})
+
+	t.Run("NonSliceReturnsError", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		_, err := MapSliceReduce(mapper, "abc", 0, sum)
+		if !errors.Is(err, ErrSrcAndDestMustBeSlices) {
+			t.Errorf("Expected ErrSrcAndDestMustBeSlices, got %v", err)
+		}
+	})
+}
+
+// TestMapSlicePointerWrappers tests that batched pointer wrappers do not alias
+func TestMapSlicePointerWrappers(t *testing.T) {
+	persons := []Person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}, {Name: "Carol", Age: 41}}
+
+	t.Run("ResultsAreDistinct", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		got, err := MapSlice[[]Person, []*PersonDTO](mapper, persons)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got[0].FullName = "changed"
+		for i, dto := range got[1:] {
+			if dto == got[0] || dto.FullName != persons[i+1].Name {
+				t.Errorf("Expected element %d to be independent, got %+v", i+1, dto)
+			}
+		}
+	})
+
+	t.Run("ArgumentsAreCopies", func(t *testing.T) {
+		mapper := New()
+		var seen []*Person
+		Register(mapper, func(p *Person) PersonDTO {
+			seen = append(seen, p)
+			dto := PersonDTO{FullName: p.Name, Years: p.Age}
+			p.Name = "mutated"
+			return dto
+		})
+
+		got, err := MapSlice[[]Person, []PersonDTO](mapper, persons)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for i, dto := range got {
+			if dto.FullName != []string{"Alice", "Bob", "Carol"}[i] {
+				t.Errorf("Expected element %d to keep its name, got %+v", i, dto)
+			}
+		}
+		if persons[0].Name != "Alice" {
+			t.Errorf("Expected source slice to be unchanged, got %+v", persons[0])
+		}
+		if seen[0] == seen[1] || seen[1] == seen[2] {
+			t.Error("Expected a distinct argument pointer per element")
+		}
+	})
+}
+
+// TestGroupBy tests mapping slice elements into groups keyed by the source element
+func TestGroupBy(t *testing.T) {
+	type Category string
+	type Event struct {
+		Title    string
+		Category Category
+	}
+	type EventDTO struct {
+		Title string
+	}
+
+	mapper := New()
+	Register(mapper, func(e Event) EventDTO { return EventDTO{Title: e.Title} })
+	byCategory := func(e Event) Category { return e.Category }
+
+	t.Run("GroupsByCategoryInOrder", func(t *testing.T) {
+		events := []Event{
+			{"Election", "news"},
+			{"Final", "sport"},
+			{"Budget", "news"},
+			{"Transfer", "sport"},
+			{"Storm", "news"},
+		}
+
+		got, err := GroupBy[Event, Category, EventDTO](mapper, events, byCategory)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := map[Category][]EventDTO{
+			"news":  {{"Election"}, {"Budget"}, {"Storm"}},
+			"sport": {{"Final"}, {"Transfer"}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("NilAndEmpty", func(t *testing.T) {
+		got, err := GroupBy[Event, Category, EventDTO](mapper, nil, byCategory)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil map, got %v, %v", got, err)
+		}
+		got, err = GroupBy[Event, Category, EventDTO](mapper, []Event{}, byCategory)
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("Expected empty map, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("Unregistered", func(t *testing.T) {
+		_, err := GroupBy[Event, Category, PersonDTO](mapper, []Event{{}}, byCategory)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+}
+
+// TestMapSliceCompact tests mapping slices while dropping nil pointer elements
+func TestMapSliceCompact(t *testing.T) {
+	mapper := New()
+	Register(mapper, personToDTO)
+
+	t.Run("DropsInterleavedNils", func(t *testing.T) {
+		src := []*Person{nil, {Name: "Ann"}, nil, nil, {Name: "Bob"}, nil}
+
+		got, err := MapSliceCompact[[]*Person, []PersonDTO](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := []PersonDTO{{FullName: "Ann"}, {FullName: "Bob"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+
+		ptrs, err := MapSliceCompact[[]*Person, []*PersonDTO](mapper, src)
+		if err != nil || len(ptrs) != 2 || ptrs[0] == nil || ptrs[1] == nil {
+			t.Errorf("Expected two non-nil pointers, got %v, %v", ptrs, err)
+		}
+	})
+
+	t.Run("NilAndAllNil", func(t *testing.T) {
+		got, err := MapSliceCompact[[]*Person, []PersonDTO](mapper, nil)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil slice, got %v, %v", got, err)
+		}
+		got, err = MapSliceCompact[[]*Person, []PersonDTO](mapper, []*Person{nil, nil})
+		if err != nil || got == nil || len(got) != 0 {
+			t.Errorf("Expected empty slice, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("ValueElementsLikeMapSlice", func(t *testing.T) {
+		src := []Person{{Name: "Ann"}, {}}
+		got, err := MapSliceCompact[[]Person, []PersonDTO](mapper, src)
+		want, _ := MapSlice[[]Person, []PersonDTO](mapper, src)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v, %v", want, got, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		if _, err := MapSliceCompact[[]*Person, []int](mapper, []*Person{{}}); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if _, err := MapSliceCompact[Person, []PersonDTO](mapper, Person{}); !errors.Is(err, ErrSrcAndDestMustBeSlices) {
+			t.Errorf("Expected ErrSrcAndDestMustBeSlices, got %v", err)
+		}
+	})
+}
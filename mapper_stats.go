@@ -0,0 +1,98 @@
+package mapper
+
+import "sync/atomic"
+
+// MapperStats is a snapshot of the counters collected by a mapper created with WithStats.
+type MapperStats struct {
+	// Hits is the number of Map calls that found a registered mapping function.
+	Hits uint64
+	// Misses is the number of Map and MapSlice calls without a registered mapping function.
+	Misses uint64
+	// FastPath is the number of Map hits served by calling the function directly.
+	FastPath uint64
+	// ReflectPath is the number of Map hits that had to call the function through reflection.
+	ReflectPath uint64
+	// SliceHits is the number of MapSlice calls that found a registered mapping function.
+	SliceHits uint64
+}
+
+// stats holds the live counters of a mapper. It is only allocated when stats are enabled,
+// so mappers created without WithStats never touch the atomics.
+type stats struct {
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	fastPath    atomic.Uint64
+	reflectPath atomic.Uint64
+	sliceHits   atomic.Uint64
+}
+
+// recordHit counts a Map call that found a mapping, split by the path used to call it.
+func (s *stats) recordHit(fast bool) {
+	s.hits.Add(1)
+	if fast {
+		s.fastPath.Add(1)
+	} else {
+		s.reflectPath.Add(1)
+	}
+}
+
+// WithStats enables hit/miss counters on the mapper. The counters are updated atomically
+// on every Map and MapSlice call, so they are disabled by default to avoid contention on
+// high-traffic mappers.
+//
+// Example:
+//
+//	mapper := New(WithStats())
+//	Register(mapper, func(s string) int { return len(s) })
+//	_, _ = Map[string, int](mapper, "hello")
+//	fmt.Println(Stats(mapper).Hits) // Output: 1
+func WithStats() Option {
+	return func(c *config) {
+		c.stats = &stats{}
+	}
+}
+
+// Stats returns a snapshot of the mapper's counters. Mappers created without WithStats
+// always report zero values.
+//
+// Parameters:
+//   - m: The mapper instance to read the counters from
+//
+// Returns:
+//   - MapperStats: The current counter values
+//
+// Example:
+//
+//	mapper := New(WithStats())
+//	_, _ = Map[string, int](mapper, "hello") // not registered
+//	fmt.Println(Stats(mapper).Misses) // Output: 1
+func Stats(m Mapper) MapperStats {
+	s := m.config.stats
+	if s == nil {
+		return MapperStats{}
+	}
+	return MapperStats{
+		Hits:        s.hits.Load(),
+		Misses:      s.misses.Load(),
+		FastPath:    s.fastPath.Load(),
+		ReflectPath: s.reflectPath.Load(),
+		SliceHits:   s.sliceHits.Load(),
+	}
+}
+
+// ResetStats sets all of the mapper's counters back to zero. It is a no-op for mappers
+// created without WithStats.
+//
+// Parameters:
+//   - m: The mapper instance whose counters are reset
+func ResetStats(m Mapper) {
+	s := m.config.stats
+	if s == nil {
+		return
+	}
+	s.hits.Store(0)
+	s.misses.Store(0)
+	s.fastPath.Store(0)
+	s.reflectPath.Store(0)
+	s.sliceHits.Store(0)
+}
@@ -0,0 +1,175 @@
+package mapper
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestStats tests the optional mapping counters
+func TestStats(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+
+		_, _ = Map[string, int](mapper, "hello")
+		_, _ = Map[int, string](mapper, 1)
+
+		if mapper.config.stats != nil {
+			t.Error("Expected stats to be disabled by default")
+		}
+		if got := Stats(mapper); got != (MapperStats{}) {
+			t.Errorf("Expected zero stats, got %+v", got)
+		}
+	})
+
+	t.Run("CountsHitsAndMisses", func(t *testing.T) {
+		mapper := New(WithStats())
+		Register(mapper, stringToInt)
+
+		_, _ = Map[string, int](mapper, "hello")
+		_, _ = Map[string, int](mapper, "world")
+		_, _ = Map[int, string](mapper, 1)
+
+		got := Stats(mapper)
+		if got.Hits != 2 {
+			t.Errorf("Expected 2 hits, got %d", got.Hits)
+		}
+		if got.Misses != 1 {
+			t.Errorf("Expected 1 miss, got %d", got.Misses)
+		}
+	})
+
+	t.Run("SplitsFastAndReflectPaths", func(t *testing.T) {
+		mapper := New(WithStats())
+		Register(mapper, personToDTO)
+
+		person := Person{Name: "John", Age: 30}
+		_, _ = Map[Person, PersonDTO](mapper, person)
+		_, _ = Map[*Person, *PersonDTO](mapper, &person)
+
+		got := Stats(mapper)
+		if got.FastPath != 1 {
+			t.Errorf("Expected 1 fast path call, got %d", got.FastPath)
+		}
+		if got.ReflectPath != 1 {
+			t.Errorf("Expected 1 reflection path call, got %d", got.ReflectPath)
+		}
+	})
+
+	t.Run("PointerFunctionUsesFastPath", func(t *testing.T) {
+		mapper := New(WithStats())
+		Register(mapper, func(p *Person) *PersonDTO {
+			if p.Name == "" {
+				return nil
+			}
+			return &PersonDTO{FullName: p.Name, Years: p.Age}
+		})
+
+		person := Person{Name: "John", Age: 30}
+		ptr, err := Map[*Person, *PersonDTO](mapper, &person)
+		if err != nil || ptr == nil || ptr.FullName != "John" {
+			t.Errorf("Expected &{John 30}, got %v (err: %v)", ptr, err)
+		}
+		value, err := Map[Person, PersonDTO](mapper, person)
+		if err != nil || value != (PersonDTO{FullName: "John", Years: 30}) {
+			t.Errorf("Expected {John 30}, got %v (err: %v)", value, err)
+		}
+		zero, err := Map[Person, PersonDTO](mapper, Person{})
+		if err != nil || zero != (PersonDTO{}) {
+			t.Errorf("Expected a nil result to map to the zero value, got %v (err: %v)", zero, err)
+		}
+
+		got := Stats(mapper)
+		if got.FastPath != 3 || got.ReflectPath != 0 {
+			t.Errorf("Expected 3 fast path calls and none via reflection, got %+v", got)
+		}
+	})
+
+	t.Run("BoundMethodsAndClosuresUseFastPath", func(t *testing.T) {
+		mapper := New(WithStats())
+		conv := personConverter{prefix: "Mr. "}
+		Register(mapper, conv.Convert)
+		Register(mapper, func(people []Person) []PersonDTO {
+			dtos := make([]PersonDTO, len(people))
+			for i, p := range people {
+				dtos[i] = MustMap[Person, PersonDTO](mapper, p)
+			}
+			return dtos
+		})
+
+		dtos, err := Map[[]Person, []PersonDTO](mapper, []Person{{Name: "John"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(dtos) != 1 || dtos[0].FullName != "Mr. John" {
+			t.Errorf("Expected [{Mr. John 0}], got %v", dtos)
+		}
+
+		got := Stats(mapper)
+		if got.FastPath != 2 || got.ReflectPath != 0 {
+			t.Errorf("Expected 2 fast path calls and none via reflection, got %+v", got)
+		}
+	})
+
+	t.Run("CountsSliceHits", func(t *testing.T) {
+		mapper := New(WithStats())
+		Register(mapper, personToDTO)
+
+		_, _ = MapSlice[[]Person, []PersonDTO](mapper, []Person{{Name: "A"}, {Name: "B"}})
+		_, _ = MapSlice[[]string, []int](mapper, []string{"x"})
+
+		got := Stats(mapper)
+		if got.SliceHits != 1 {
+			t.Errorf("Expected 1 slice hit, got %d", got.SliceHits)
+		}
+		if got.Misses != 1 {
+			t.Errorf("Expected 1 miss, got %d", got.Misses)
+		}
+		if got.Hits != 0 {
+			t.Errorf("Expected slice calls to not count as Map hits, got %d", got.Hits)
+		}
+	})
+
+	t.Run("ResetStats", func(t *testing.T) {
+		mapper := New(WithStats())
+		Register(mapper, stringToInt)
+
+		_, _ = Map[string, int](mapper, "hello")
+		ResetStats(mapper)
+
+		if got := Stats(mapper); got != (MapperStats{}) {
+			t.Errorf("Expected zero stats after reset, got %+v", got)
+		}
+
+		_, _ = Map[string, int](mapper, "hello")
+		if got := Stats(mapper); got.Hits != 1 {
+			t.Errorf("Expected 1 hit after reset, got %d", got.Hits)
+		}
+	})
+
+	t.Run("ResetStatsDisabledNoPanic", func(t *testing.T) {
+		mapper := New()
+		ResetStats(mapper)
+	})
+
+	t.Run("ConcurrentMapCalls", func(t *testing.T) {
+		mapper := New(WithStats())
+		Register(mapper, stringToInt)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					_, _ = Map[string, int](mapper, "hello")
+				}
+			}()
+		}
+		wg.Wait()
+
+		if got := Stats(mapper).Hits; got != 800 {
+			t.Errorf("Expected 800 hits, got %d", got)
+		}
+	})
+}
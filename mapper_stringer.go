@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"fmt"
+)
+
+// RegisterStringer registers the mappings between an enum-like type E and its string
+// names: E -> string calls the String method, and string -> E calls parse. It covers the
+// usual case of an integer enum serialized by name in DTOs.
+//
+// If parse returns an error, such as for an unknown name, Map and the slice helpers return
+// an error wrapping it, so sentinel errors returned by parse can be matched with
+// errors.Is. Existing mappings for these pairs are replaced.
+//
+// Type Parameters:
+//   - E: The enum type; it must implement fmt.Stringer
+//
+// Parameters:
+//   - m: The mapper instance to register the conversions with
+//   - parse: Function converting a name back to an E
+//
+// Panics:
+//   - If parse is nil, with an error wrapping ErrNilMappingFunc
+//
+// Example:
+//
+//	type Color int
+//
+//	const (
+//	    Red Color = iota
+//	    Green
+//	)
+//
+//	func (c Color) String() string { return [...]string{"red", "green"}[c] }
+//
+//	mapper := New()
+//	RegisterStringer(mapper, func(s string) (Color, error) {
+//	    switch s {
+//	    case "red":
+//	        return Red, nil
+//	    case "green":
+//	        return Green, nil
+//	    }
+//	    return 0, fmt.Errorf("unknown color %q", s)
+//	})
+//
+//	name, _ := Map[Color, string](mapper, Green)
+//	fmt.Println(name) // Output: green
+func RegisterStringer[E fmt.Stringer](m Mapper, parse func(string) (E, error)) {
+	if parse == nil {
+		panic(nilFuncError(typePair{src: typeOf[string](), dst: typeOf[E]()}))
+	}
+
+	Register(m, func(e E) string { return e.String() })
+	registerFallible(m, func(s string) E {
+		e, err := parse(s)
+		if err != nil {
+			failMapping(fmt.Errorf("parse %s from %q: %w", typeOf[E](), s, err))
+		}
+		return e
+	})
+}
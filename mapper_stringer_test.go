@@ -0,0 +1,96 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type color int
+
+const (
+	red color = iota
+	green
+	blue
+)
+
+var errUnknownColor = errors.New("unknown color")
+
+func (c color) String() string {
+	switch c {
+	case red:
+		return "red"
+	case green:
+		return "green"
+	case blue:
+		return "blue"
+	}
+	return fmt.Sprintf("color(%d)", int(c))
+}
+
+func parseColor(s string) (color, error) {
+	for _, c := range []color{red, green, blue} {
+		if c.String() == s {
+			return c, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", errUnknownColor, s)
+}
+
+// TestRegisterStringer tests mapping enum types to and from their string names
+func TestRegisterStringer(t *testing.T) {
+	mapper := New()
+	RegisterStringer(mapper, parseColor)
+
+	t.Run("EnumToString", func(t *testing.T) {
+		got, err := Map[color, string](mapper, green)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != "green" {
+			t.Errorf("Expected green, got %s", got)
+		}
+	})
+
+	t.Run("StringToEnum", func(t *testing.T) {
+		got, err := Map[string, color](mapper, "blue")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != blue {
+			t.Errorf("Expected blue, got %v", got)
+		}
+	})
+
+	t.Run("UnknownStringReturnsError", func(t *testing.T) {
+		_, err := Map[string, color](mapper, "purple")
+		if !errors.Is(err, errUnknownColor) {
+			t.Errorf("Expected errUnknownColor, got %v", err)
+		}
+	})
+
+	t.Run("Slices", func(t *testing.T) {
+		got, err := MapSlice[[]string, []color](mapper, []string{"red", "blue"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := []color{red, blue}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+
+		if _, err := MapSlice[[]string, []color](mapper, []string{"red", "purple"}); !errors.Is(err, errUnknownColor) {
+			t.Errorf("Expected errUnknownColor, got %v", err)
+		}
+	})
+
+	t.Run("NilParsePanics", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			if err, ok := r.(error); !ok || !errors.Is(err, ErrNilMappingFunc) {
+				t.Errorf("Expected ErrNilMappingFunc panic, got %v", r)
+			}
+		}()
+		RegisterStringer[color](New(), nil)
+	})
+}
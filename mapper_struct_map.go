@@ -0,0 +1,201 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrMapValueType is returned by MapToStruct when a map value cannot be stored in the
+// struct field it names.
+var ErrMapValueType = errors.New("map value cannot be assigned to struct field")
+
+// StructToMap converts a struct into a map[string]any keyed by its exported field names.
+// It complements the registry for dynamic contexts, such as generic serialization or
+// template data, where the destination shape is not a Go type.
+//
+// Fields are named like auto-mapped fields, using the mapper's default AutoMapOptions: a
+// configured TagKey or MatchByJSONTag names fields by their tag, fields tagged "-" and
+// fields listed in IgnoreFields are left out, and fields promoted from embedded structs
+// appear at the top level. Nested structs and non-nil pointers to structs are converted
+// recursively into nested maps; a nil pointer becomes a nil value. All other field values,
+// including slices and structs without exported fields such as time.Time, are stored as-is.
+// Values containing pointer cycles are not supported.
+//
+// Type Parameters:
+//   - S: Source type; a struct or a pointer to a struct
+//
+// Parameters:
+//   - m: The mapper whose AutoMapOptions name the fields
+//   - src: The struct to convert
+//
+// Returns:
+//   - map[string]any: The field values by name, or nil if src is a nil pointer
+//   - error: An error wrapping ErrAutoMapUnsupported if S is not a struct or a pointer to
+//     a struct
+//
+// Example:
+//
+//	mapper := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true}))
+//
+//	fields, err := StructToMap(mapper, User{ID: 1, Name: "John"})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(fields["name"]) // Output: John
+func StructToMap[S any](m Mapper, src S) (map[string]any, error) {
+	srcType := typeOf[S]()
+	if derefType(srcType).Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s", ErrAutoMapUnsupported, srcType)
+	}
+
+	srcValue := reflect.ValueOf(src)
+	if srcType.Kind() == reflect.Pointer {
+		if srcValue.IsNil() {
+			return nil, nil
+		}
+		srcValue = srcValue.Elem()
+	}
+	return structToMap(srcValue, m.config.autoMap), nil
+}
+
+// structToMap converts the struct value v into a map keyed by field name under opts.
+func structToMap(v reflect.Value, opts AutoMapOptions) map[string]any {
+	keyOpts := opts
+	keyOpts.CaseInsensitive = false
+
+	fields := mappableFields(v.Type())
+	out := make(map[string]any, len(fields))
+	for _, f := range fields {
+		name, ok := fieldName(f, keyOpts)
+		if !ok {
+			continue
+		}
+
+		fv := v.FieldByIndex(f.Index)
+		if !nestsAsMap(f.Type) {
+			out[name] = fv.Interface()
+			continue
+		}
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				out[name] = nil
+				continue
+			}
+			fv = fv.Elem()
+		}
+		out[name] = structToMap(fv, opts)
+	}
+	return out
+}
+
+// MapToStruct builds a struct of type D from a map[string]any, the inverse of StructToMap.
+// Keys are matched to fields named as in StructToMap; with CaseInsensitive set in the
+// mapper's AutoMapOptions they are matched regardless of case. Keys without a matching
+// field are ignored and fields without a key keep their zero value.
+//
+// Values are stored in their fields when assignable. Numbers are converted between numeric
+// kinds, so values decoded by encoding/json as float64 can fill int fields. A nested
+// map[string]any fills a nested struct or pointer-to-struct field recursively, and a nil
+// value leaves the field at its zero value.
+//
+// Type Parameters:
+//   - D: Destination type; a struct or a pointer to a struct
+//
+// Parameters:
+//   - m: The mapper whose AutoMapOptions name the fields
+//   - src: The field values by name; may be nil
+//
+// Returns:
+//   - D: The populated struct; for a pointer type, a pointer to a new struct
+//   - error: An error wrapping ErrAutoMapUnsupported if D is not a struct or a pointer to
+//     a struct, or an error wrapping ErrMapValueType naming the first key whose value does
+//     not fit its field
+//
+// Example:
+//
+//	mapper := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true}))
+//
+//	user, err := MapToStruct[User](mapper, map[string]any{"id": 1, "name": "John"})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(user.Name) // Output: John
+func MapToStruct[D any](m Mapper, src map[string]any) (D, error) {
+	var dst D
+
+	dstType := typeOf[D]()
+	if derefType(dstType).Kind() != reflect.Struct {
+		return dst, fmt.Errorf("%w: %s", ErrAutoMapUnsupported, dstType)
+	}
+
+	out := reflect.New(derefType(dstType))
+	if err := mapToStruct(out.Elem(), src, m.config.autoMap, ""); err != nil {
+		return dst, err
+	}
+	if dstType.Kind() == reflect.Pointer {
+		return out.Interface().(D), nil
+	}
+	return out.Elem().Interface().(D), nil
+}
+
+// mapToStruct fills the addressable struct v from src under opts. path prefixes the keys
+// named in errors for nested structs.
+func mapToStruct(v reflect.Value, src map[string]any, opts AutoMapOptions, path string) error {
+	if opts.CaseInsensitive {
+		folded := make(map[string]any, len(src))
+		for k, val := range src {
+			folded[strings.ToLower(k)] = val
+		}
+		src = folded
+	}
+
+	for _, f := range mappableFields(v.Type()) {
+		name, ok := fieldName(f, opts)
+		if !ok {
+			continue
+		}
+		val, ok := src[name]
+		if !ok || val == nil {
+			continue
+		}
+
+		fv := v.FieldByIndex(f.Index)
+		if nested, isMap := val.(map[string]any); isMap && nestsAsMap(f.Type) {
+			target := fv
+			if f.Type.Kind() == reflect.Pointer {
+				target = reflect.New(f.Type.Elem())
+				fv.Set(target)
+				target = target.Elem()
+			}
+			if err := mapToStruct(target, nested, opts, path+name+"."); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rv := reflect.ValueOf(val)
+		switch {
+		case rv.Type().AssignableTo(f.Type):
+			fv.Set(rv)
+		case isNumber(rv.Kind()) && isNumber(f.Type.Kind()):
+			fv.Set(rv.Convert(f.Type))
+		default:
+			return fmt.Errorf("%w: %s%s is %s, field is %s", ErrMapValueType, path, name, rv.Type(), f.Type)
+		}
+	}
+	return nil
+}
+
+// nestsAsMap reports whether fields of type t are converted to and from nested maps:
+// structs and pointers to structs that have mappable fields of their own.
+func nestsAsMap(t reflect.Type) bool {
+	t = derefType(t)
+	return t.Kind() == reflect.Struct && len(mappableFields(t)) > 0
+}
+
+// isNumber reports whether k is an integer, unsigned integer or floating-point kind.
+func isNumber(k reflect.Kind) bool {
+	return reflect.Int <= k && k <= reflect.Float64
+}
@@ -0,0 +1,148 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestStructToMap tests converting structs to and from map[string]any
+func TestStructToMap(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+		Zip  string `json:"zip"`
+	}
+	type User struct {
+		ID       int       `json:"id"`
+		Name     string    `json:"name"`
+		Password string    `json:"-"`
+		Home     Address   `json:"home"`
+		Work     *Address  `json:"work"`
+		Tags     []string  `json:"tags"`
+		Joined   time.Time `json:"joined"`
+	}
+
+	joined := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	user := User{
+		ID:       1,
+		Name:     "John",
+		Password: "secret",
+		Home:     Address{City: "Hanoi", Zip: "100000"},
+		Work:     &Address{City: "Saigon"},
+		Tags:     []string{"admin"},
+		Joined:   joined,
+	}
+
+	t.Run("NestedStructs", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true}))
+
+		got, err := StructToMap(mapper, user)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want := map[string]any{
+			"id":     1,
+			"name":   "John",
+			"home":   map[string]any{"city": "Hanoi", "zip": "100000"},
+			"work":   map[string]any{"city": "Saigon", "zip": ""},
+			"tags":   []string{"admin"},
+			"joined": joined,
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true}))
+
+		fields, err := StructToMap(mapper, &user)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got, err := MapToStruct[User](mapper, fields)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		want := user
+		want.Password = ""
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+		if got.Work == user.Work {
+			t.Error("Expected a new nested pointer, got the original")
+		}
+	})
+
+	t.Run("GoFieldNamesByDefault", func(t *testing.T) {
+		mapper := New()
+
+		got, err := StructToMap(mapper, Address{City: "Hanoi"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, map[string]any{"City": "Hanoi", "Zip": ""}) {
+			t.Errorf("Expected Go field names, got %v", got)
+		}
+	})
+
+	t.Run("NilPointers", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true}))
+
+		got, err := StructToMap[*User](mapper, nil)
+		if err != nil || got != nil {
+			t.Errorf("Expected nil map for nil source, got %v (err: %v)", got, err)
+		}
+
+		fields, _ := StructToMap(mapper, User{})
+		if v, ok := fields["work"]; !ok || v != nil {
+			t.Errorf("Expected nil work entry, got %v", v)
+		}
+	})
+
+	t.Run("ConvertsNumbers", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true}))
+
+		got, err := MapToStruct[*User](mapper, map[string]any{"id": float64(7), "unknown": true})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got == nil || got.ID != 7 {
+			t.Errorf("Expected ID 7, got %+v", got)
+		}
+	})
+
+	t.Run("CaseInsensitiveKeys", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{CaseInsensitive: true}))
+
+		got, err := MapToStruct[Address](mapper, map[string]any{"city": "Hanoi"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.City != "Hanoi" {
+			t.Errorf("Expected Hanoi, got %q", got.City)
+		}
+	})
+
+	t.Run("WrongValueType", func(t *testing.T) {
+		mapper := New(WithAutoMapOptions(AutoMapOptions{MatchByJSONTag: true}))
+
+		_, err := MapToStruct[User](mapper, map[string]any{"home": map[string]any{"zip": 100000}})
+		if !errors.Is(err, ErrMapValueType) {
+			t.Errorf("Expected ErrMapValueType, got %v", err)
+		}
+	})
+
+	t.Run("NonStructTypes", func(t *testing.T) {
+		mapper := New()
+
+		if _, err := StructToMap(mapper, "text"); !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported, got %v", err)
+		}
+		if _, err := MapToStruct[int](mapper, nil); !errors.Is(err, ErrAutoMapUnsupported) {
+			t.Errorf("Expected ErrAutoMapUnsupported, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrAutoMapTag is returned, wrapped, by RegisterFromTags for a sample that is not a struct
+// or whose automap tags do not follow the convention described there.
+var ErrAutoMapTag = errors.New("invalid automap tag")
+
+// autoMapTagKey is the struct tag key that marks AutoMapFrom fields.
+const autoMapTagKey = "automap"
+
+// AutoMapFrom is a zero-size marker naming the source type S that the DTO type D is
+// auto-mapped from. Declared as a field of D tagged `automap:"source"`, it lets
+// RegisterFromTags discover and register the mapping. It has no effect on its own.
+//
+// Type Parameters:
+//   - S: The source type, such as a model
+//   - D: The DTO type declaring the marker field
+type AutoMapFrom[S any, D any] struct{}
+
+// registerAutoMap registers the auto-mapping between S and D with m.
+func (AutoMapFrom[S, D]) registerAutoMap(m Mapper) {
+	RegisterAutoMap[S, D](m)
+}
+
+// pair returns the source and destination types named by the marker.
+func (AutoMapFrom[S, D]) pair() (reflect.Type, reflect.Type) {
+	return typeOf[S](), typeOf[D]()
+}
+
+// autoMapMarker is implemented by every instantiation of AutoMapFrom.
+type autoMapMarker interface {
+	registerAutoMap(m Mapper)
+	pair() (reflect.Type, reflect.Type)
+}
+
+// RegisterFromTags registers the auto-mappings declared on DTO types with automap tags, so
+// that large codebases can keep the pairing next to each DTO instead of in a central
+// wiring function.
+//
+// Go cannot look a type up by its name at runtime, so the source type is named by the type
+// of a marker field rather than by a string. The tag convention is:
+//
+//   - A DTO type D declares a field of type AutoMapFrom[S, D] tagged `automap:"source"` for
+//     each source type S it is mapped from. The field is usually the blank identifier and
+//     declared first, where its zero size adds no padding.
+//   - For each such field, RegisterFromTags calls RegisterAutoMap[S, D], which registers
+//     both directions using the mapper's default AutoMapOptions.
+//   - Marker fields without the tag are ignored, so a marker can be kept for documentation
+//     only. An automap tag with any other value, on a field of any other type, or a marker
+//     whose D is not the declaring type, is an error.
+//
+// Only the top-level fields of each sample's type are inspected. All samples are validated
+// before anything is registered.
+//
+// Parameters:
+//   - m: The mapper instance to register the auto-mappings with
+//   - dtoSamples: Values of the DTO types, or pointers to them; only their types are used
+//
+// Returns:
+//   - error: nil on success, or an error describing every invalid sample, each wrapping
+//     ErrAutoMapTag, in which case nothing is registered
+//
+// Panics:
+//   - As RegisterAutoMap does, for instance if the mapper's AutoMapOptions.Defaults do not
+//     fit a registered type
+//
+// Example:
+//
+//	type UserDTO struct {
+//	    _     mapper.AutoMapFrom[model.User, UserDTO] `automap:"source"`
+//	    Name  string
+//	    Email string
+//	}
+//
+//	func init() {
+//	    if err := mapper.RegisterFromTags(mapper.Global, UserDTO{}, OrderDTO{}); err != nil {
+//	        panic(err)
+//	    }
+//	}
+func RegisterFromTags(m Mapper, dtoSamples ...any) error {
+	var markers []autoMapMarker
+	var errs []error
+	for i, sample := range dtoSamples {
+		found, err := tagMarkers(reflect.TypeOf(sample))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w at index %d: %w", ErrAutoMapTag, i, err))
+			continue
+		}
+		markers = append(markers, found...)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	for _, marker := range markers {
+		marker.registerAutoMap(m)
+	}
+	return nil
+}
+
+// tagMarkers returns the AutoMapFrom markers declared with an automap tag on the struct
+// type t, or on the struct t points to.
+func tagMarkers(t reflect.Type) ([]autoMapMarker, error) {
+	if t == nil || derefType(t).Kind() != reflect.Struct {
+		return nil, fmt.Errorf("got %v, want a struct or a pointer to a struct", t)
+	}
+	t = derefType(t)
+
+	var markers []autoMapMarker
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup(autoMapTagKey)
+		if !ok {
+			continue
+		}
+		if tag != "source" {
+			return nil, fmt.Errorf("%s.%s: tag %q, want %q", t, f.Name, tag, "source")
+		}
+		marker, ok := reflect.Zero(f.Type).Interface().(autoMapMarker)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s is %s, want AutoMapFrom[S, %s]", t, f.Name, f.Type, t.Name())
+		}
+		if _, dst := marker.pair(); dst != t {
+			return nil, fmt.Errorf("%s.%s maps to %s, want %s", t, f.Name, dst, t)
+		}
+		markers = append(markers, marker)
+	}
+	return markers, nil
+}
@@ -0,0 +1,99 @@
+package mapper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Annotated DTO types for RegisterFromTags tests
+type (
+	taggedUser struct {
+		Name  string
+		Email string
+	}
+
+	taggedPersonDTO struct {
+		_        AutoMapFrom[Person, taggedPersonDTO] `automap:"source"`
+		Name     string
+		Age      int
+		Nickname string
+	}
+
+	taggedContactDTO struct {
+		_    AutoMapFrom[Person, taggedContactDTO]     `automap:"source"`
+		_    AutoMapFrom[taggedUser, taggedContactDTO] `automap:"source"`
+		Name string
+	}
+
+	untaggedMarkerDTO struct {
+		_    AutoMapFrom[Person, untaggedMarkerDTO]
+		Name string
+	}
+
+	wrongTagValueDTO struct {
+		_    AutoMapFrom[Person, wrongTagValueDTO] `automap:"src"`
+		Name string
+	}
+
+	wrongTagTypeDTO struct {
+		Name string `automap:"source"`
+	}
+
+	wrongTargetDTO struct {
+		_    AutoMapFrom[Person, PersonDTO] `automap:"source"`
+		Name string
+	}
+)
+
+// TestRegisterFromTags tests registering auto-mappings declared by automap tags
+func TestRegisterFromTags(t *testing.T) {
+	t.Run("RegistersAnnotatedTypes", func(t *testing.T) {
+		mapper := New()
+		if err := RegisterFromTags(mapper, taggedPersonDTO{}, &taggedContactDTO{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		dto, err := Map[Person, taggedPersonDTO](mapper, Person{Name: "Ann", Age: 30})
+		if err != nil || dto.Name != "Ann" || dto.Age != 30 {
+			t.Errorf("Expected {Ann 30}, got %+v, %v", dto, err)
+		}
+		back, err := Map[taggedPersonDTO, Person](mapper, taggedPersonDTO{Name: "Bob"})
+		if err != nil || back.Name != "Bob" {
+			t.Errorf("Expected Bob, got %+v, %v", back, err)
+		}
+		contact, err := Map[taggedUser, taggedContactDTO](mapper, taggedUser{Name: "Cid"})
+		if err != nil || contact.Name != "Cid" {
+			t.Errorf("Expected Cid, got %+v, %v", contact, err)
+		}
+		if got := ListByKind(mapper, true); len(got) != 6 {
+			t.Errorf("Expected 6 auto-mapped pairs, got %v", got)
+		}
+	})
+
+	t.Run("IgnoresUntaggedMarkers", func(t *testing.T) {
+		mapper := New()
+		if err := RegisterFromTags(mapper, untaggedMarkerDTO{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := List(mapper); len(got) != 0 {
+			t.Errorf("Expected no mappings, got %v", got)
+		}
+	})
+
+	t.Run("InvalidSamplesRegisterNothing", func(t *testing.T) {
+		mapper := New()
+		err := RegisterFromTags(mapper, taggedPersonDTO{}, wrongTagValueDTO{}, wrongTagTypeDTO{}, wrongTargetDTO{}, 42, nil)
+		if !errors.Is(err, ErrAutoMapTag) {
+			t.Fatalf("Expected ErrAutoMapTag, got %v", err)
+		}
+		for _, want := range []string{"index 1", `tag "src"`, "index 2", "Name is string", "index 3", "maps to mapper.PersonDTO", "index 4", "index 5"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to contain %q, got %v", want, err)
+			}
+		}
+		if got := List(mapper); len(got) != 0 {
+			t.Errorf("Expected no mappings, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrDestinationNotPointer is returned by MapTee for a destination that is not a pointer.
+var ErrDestinationNotPointer = errors.New("destination must be a pointer")
+
+// MapTee maps src to several destination types in one call, storing each result in the
+// value its destination pointer points to. It keeps fan-out code short, such as an API
+// gateway rendering one internal model into the shapes of different clients.
+//
+// Each destination is mapped like Map[S, D] with D the type its pointer points to:
+// conditional mappings registered with RegisterWhen are tried first, then the registered
+// mapping, and a nil pointer source yields the default set with RegisterNilDefault or the
+// zero value. Post-processing functions run on every mapped destination, and each
+// destination counts as one Map call in stats. The fallback installed with SetFallback is
+// not used. The destination type may itself be a pointer, so a **DTO receives a newly
+// mapped *DTO. Destinations whose mapping fails keep their value and do not stop the
+// others.
+//
+// Type Parameters:
+//   - S: Source type
+//
+// Parameters:
+//   - m: The mapper instance containing the registered mapping functions
+//   - src: The source value to be mapped
+//   - dsts: Pointers to the destination values
+//
+// Returns:
+//   - error: nil if every destination was mapped, or the errors of the failed
+//     destinations joined with errors.Join, each naming the destination's index and type
+//     and wrapping ErrNoMapping, ErrSignatureMismatch, ErrNilDestination or
+//     ErrDestinationNotPointer
+//
+// Example:
+//
+//	var web WebUserDTO
+//	var mobile MobileUserDTO
+//	if err := MapTee(mapper, user, &web, &mobile); err != nil {
+//	    log.Fatal(err)
+//	}
+func MapTee[S any](m Mapper, src S, dsts ...any) error {
+	srcValue := reflect.ValueOf(src)
+	if !srcValue.IsValid() {
+		return fmt.Errorf("%w to %d destinations", ErrUntypedNil, len(dsts))
+	}
+	isNil := srcValue.Kind() == reflect.Pointer && srcValue.IsNil()
+
+	var errs []error
+	for i, dst := range dsts {
+		if err := mapTeeInto(m, src, srcValue, isNil, dst); err != nil {
+			errs = append(errs, fmt.Errorf("destination %d (%T): %w", i, dst, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// mapTeeInto maps src into the destination pointer dst for MapTee.
+func mapTeeInto[S any](m Mapper, src S, srcValue reflect.Value, isNil bool, dst any) error {
+	dstPtr := reflect.ValueOf(dst)
+	switch {
+	case dst == nil:
+		return ErrNilDestination
+	case dstPtr.Kind() != reflect.Pointer:
+		return ErrDestinationNotPointer
+	case dstPtr.IsNil():
+		return ErrNilDestination
+	}
+
+	dstType := dstPtr.Type().Elem()
+	key := pairOf(srcValue.Type(), dstType)
+	fn, ok := matchConditional(m, key, src)
+	if !ok {
+		fn, ok = m.registry.get(key)
+	}
+	if !ok {
+		if st := m.config.stats; st != nil {
+			st.misses.Add(1)
+		}
+		return ErrNoMapping
+	}
+	if st := m.config.stats; st != nil {
+		st.recordHit(false)
+	}
+
+	if isNil {
+		dstPtr.Elem().Set(nilResult(m.config.nilDefaults[key], dstType))
+		return nil
+	}
+	mapped, err := mapValue(fn, srcValue, dstType)
+	if err != nil {
+		return err
+	}
+	dstPtr.Elem().Set(mapped)
+	runPostProcess(m.config.postProcess[derefType(dstType)], dstPtr.Elem())
+	return nil
+}
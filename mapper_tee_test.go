@@ -0,0 +1,102 @@
+package mapper
+
+import (
+	"errors"
+	"testing"
+)
+
+type webPersonDTO struct {
+	DisplayName string
+}
+
+type mobilePersonDTO struct {
+	Name string
+	Age  int
+}
+
+// TestMapTee tests mapping one source into several destination types
+func TestMapTee(t *testing.T) {
+	newMapper := func(opts ...Option) Mapper {
+		mapper := New(opts...)
+		Register(mapper, func(p Person) webPersonDTO { return webPersonDTO{DisplayName: p.Name} })
+		Register(mapper, func(p *Person) *mobilePersonDTO { return &mobilePersonDTO{Name: p.Name, Age: p.Age} })
+		return mapper
+	}
+	person := Person{Name: "Alice", Age: 30}
+
+	t.Run("TwoDestinations", func(t *testing.T) {
+		var web webPersonDTO
+		var mobile mobilePersonDTO
+
+		if err := MapTee(newMapper(), person, &web, &mobile); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if web.DisplayName != "Alice" {
+			t.Errorf("Expected Alice, got %+v", web)
+		}
+		if mobile != (mobilePersonDTO{Name: "Alice", Age: 30}) {
+			t.Errorf("Expected {Alice 30}, got %+v", mobile)
+		}
+	})
+
+	t.Run("PostProcessAndStats", func(t *testing.T) {
+		mapper := newMapper(WithStats())
+		RegisterPostProcess(mapper, func(dto *webPersonDTO) { dto.DisplayName += "!" })
+		RegisterPostProcess(mapper, func(dto *mobilePersonDTO) { dto.Age++ })
+
+		var web webPersonDTO
+		var mobile *mobilePersonDTO
+		if err := MapTee(mapper, person, &web, &mobile); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		want, _ := Map[Person, webPersonDTO](mapper, person)
+		if web != want {
+			t.Errorf("Expected %+v like Map, got %+v", want, web)
+		}
+		if mobile == nil || mobile.Age != 31 {
+			t.Errorf("Expected the post-processed age 31, got %+v", mobile)
+		}
+		if hits := Stats(mapper).Hits; hits != 3 {
+			t.Errorf("Expected 3 hits, got %d", hits)
+		}
+	})
+
+	t.Run("PointerDestinationsAndSource", func(t *testing.T) {
+		var web *webPersonDTO
+		var mobile *mobilePersonDTO
+
+		if err := MapTee(newMapper(), &person, &web, &mobile); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if web == nil || web.DisplayName != "Alice" || mobile == nil || mobile.Age != 30 {
+			t.Errorf("Expected both destinations to be mapped, got %+v, %+v", web, mobile)
+		}
+
+		if err := MapTee[*Person](newMapper(), nil, &web, &mobile); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if web != nil || mobile != nil {
+			t.Errorf("Expected nil results for nil source, got %+v, %+v", web, mobile)
+		}
+	})
+
+	t.Run("CombinedErrors", func(t *testing.T) {
+		web := webPersonDTO{DisplayName: "unchanged"}
+		var dto PersonDTO
+		var notPointer mobilePersonDTO
+
+		err := MapTee(newMapper(), person, &web, &dto, notPointer, nil)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if !errors.Is(err, ErrDestinationNotPointer) {
+			t.Errorf("Expected ErrDestinationNotPointer, got %v", err)
+		}
+		if !errors.Is(err, ErrNilDestination) {
+			t.Errorf("Expected ErrNilDestination, got %v", err)
+		}
+		if web.DisplayName != "Alice" {
+			t.Errorf("Expected the valid destination to be mapped, got %+v", web)
+		}
+	})
+}
@@ -1,8 +1,11 @@
 package mapper
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
 	"sort"
 	"strings"
 	"testing"
@@ -30,8 +33,25 @@ type (
 	GenericStruct[T any] struct {
 		Data T
 	}
+
+	GenericStructDTO[T any] struct {
+		Data T
+	}
 )
 
+// Animal is an interface destination used to test concrete -> interface mappings
+type Animal interface {
+	Sound() string
+}
+
+type Cat struct{ Name string }
+
+type Dog struct{ Name string }
+
+func (c Cat) Sound() string { return c.Name + " says meow" }
+
+func (d Dog) Sound() string { return d.Name + " says woof" }
+
 // Helper mapping functions
 func personToDTO(p Person) PersonDTO {
 	return PersonDTO{
@@ -64,8 +84,8 @@ func TestNewMapper(t *testing.T) {
 			t.Error("Expected registry to be initialized")
 		}
 
-		if len(mapper.registry) != 0 {
-			t.Errorf("Expected empty registry, got %d items", len(mapper.registry))
+		if len(mapper.registry.snapshot()) != 0 {
+			t.Errorf("Expected empty registry, got %d items", len(mapper.registry.snapshot()))
 		}
 	})
 
@@ -146,6 +166,28 @@ func TestRegister(t *testing.T) {
 		}
 	})
 
+	t.Run("RegisterNilFunctionPanics", func(t *testing.T) {
+		mapper := New()
+
+		defer func() {
+			r := recover()
+			err, ok := r.(error)
+			if !ok || !errors.Is(err, ErrNilMappingFunc) {
+				t.Fatalf("Expected panic with ErrNilMappingFunc, got %v", r)
+			}
+			expected := "cannot register nil mapping function for mapper.Person->mapper.PersonDTO"
+			if err.Error() != expected {
+				t.Errorf("Expected %q, got %q", expected, err.Error())
+			}
+			if Has[Person, PersonDTO](mapper) {
+				t.Error("Expected nil function to not be registered")
+			}
+		}()
+
+		var fn func(Person) PersonDTO
+		Register(mapper, fn)
+	})
+
 	t.Run("RegisterBidirectionalMappings", func(t *testing.T) {
 		mapper := New()
 		Register(mapper, stringToInt)
@@ -387,6 +429,182 @@ func TestMap(t *testing.T) {
 	})
 }
 
+// TestMapSignatureMismatch tests that reflection panics from wrongly registered functions
+// are returned as errors
+func TestMapSignatureMismatch(t *testing.T) {
+	personKey := typePair{src: reflect.TypeOf(Person{}), dst: reflect.TypeOf(PersonDTO{})}
+
+	t.Run("WrongParameterTypeReturnsError", func(t *testing.T) {
+		mapper := New()
+		mapper.registry.set(personKey, func(i int) PersonDTO { return PersonDTO{} })
+
+		_, err := Map[*Person, PersonDTO](mapper, &Person{Name: "John"})
+		if !errors.Is(err, ErrSignatureMismatch) {
+			t.Fatalf("Expected ErrSignatureMismatch, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "mapper.Person->mapper.PersonDTO") {
+			t.Errorf("Expected error to name the type pair, got: %v", err)
+		}
+	})
+
+	t.Run("WrongResultTypeReturnsError", func(t *testing.T) {
+		mapper := New()
+		mapper.registry.set(personKey, func(p Person) string { return p.Name })
+
+		_, err := Map[Person, PersonDTO](mapper, Person{Name: "John"})
+		if !errors.Is(err, ErrSignatureMismatch) {
+			t.Errorf("Expected ErrSignatureMismatch, got %v", err)
+		}
+	})
+
+	t.Run("NonFunctionReturnsError", func(t *testing.T) {
+		mapper := New()
+		mapper.registry.set(personKey, "not a function")
+
+		_, err := Map[Person, PersonDTO](mapper, Person{Name: "John"})
+		if !errors.Is(err, ErrSignatureMismatch) {
+			t.Errorf("Expected ErrSignatureMismatch, got %v", err)
+		}
+	})
+
+	t.Run("MapSliceWrongFunctionReturnsError", func(t *testing.T) {
+		mapper := New()
+		mapper.registry.set(personKey, func(i int) PersonDTO { return PersonDTO{} })
+
+		_, err := MapSlice[[]Person, []PersonDTO](mapper, []Person{{Name: "John"}})
+		if !errors.Is(err, ErrSignatureMismatch) {
+			t.Errorf("Expected ErrSignatureMismatch, got %v", err)
+		}
+	})
+
+	t.Run("PanicInMappingFunctionPropagates", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(p Person) PersonDTO { panic("boom") })
+
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("Expected mapping function panic to propagate, got %v", r)
+			}
+		}()
+
+		_, _ = Map[*Person, PersonDTO](mapper, &Person{Name: "John"})
+	})
+
+	t.Run("TypeAssertionInMappingFunctionPropagates", func(t *testing.T) {
+		mapper := New()
+		// Takes a pointer, so Map[Person, PersonDTO] calls it through reflection
+		Register(mapper, func(p *Person) PersonDTO {
+			var name any = p.Name
+			return PersonDTO{Years: name.(int)}
+		})
+
+		defer func() {
+			if _, ok := recover().(*runtime.TypeAssertionError); !ok {
+				t.Error("Expected the type assertion panic to propagate")
+			}
+		}()
+
+		_, err := Map[Person, PersonDTO](mapper, Person{Name: "John"})
+		t.Errorf("Expected a panic, got error %v", err)
+	})
+}
+
+// TestMapInterfaceDestination tests mapping concrete types into interface destinations
+func TestMapInterfaceDestination(t *testing.T) {
+	catToAnimal := func(c Cat) Animal { return c }
+	dogToAnimal := func(d Dog) Animal { return d }
+
+	t.Run("MapConcreteToInterface", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, catToAnimal)
+		Register(mapper, dogToAnimal)
+
+		cat, err := Map[Cat, Animal](mapper, Cat{Name: "Tom"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if cat.Sound() != "Tom says meow" {
+			t.Errorf("Expected cat sound, got %q", cat.Sound())
+		}
+
+		dog, err := Map[Dog, Animal](mapper, Dog{Name: "Rex"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dog.Sound() != "Rex says woof" {
+			t.Errorf("Expected dog sound, got %q", dog.Sound())
+		}
+	})
+
+	t.Run("HasInterfaceMapping", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, catToAnimal)
+
+		if !Has[Cat, Animal](mapper) {
+			t.Error("Expected Cat->Animal mapping to be registered")
+		}
+		if Has[Dog, Animal](mapper) {
+			t.Error("Expected Dog->Animal mapping to not exist")
+		}
+	})
+
+	t.Run("MapPointerToInterface", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, catToAnimal)
+
+		animal, err := Map[*Cat, Animal](mapper, &Cat{Name: "Tom"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if animal == nil || animal.Sound() != "Tom says meow" {
+			t.Errorf("Expected cat sound, got %v", animal)
+		}
+
+		animal, err = Map[*Cat, Animal](mapper, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if animal != nil {
+			t.Errorf("Expected nil interface for nil source, got %v", animal)
+		}
+	})
+
+	t.Run("FunctionReturningNilInterface", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(c Cat) Animal { return nil })
+
+		animal, err := Map[*Cat, Animal](mapper, &Cat{Name: "Tom"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if animal != nil {
+			t.Errorf("Expected nil interface, got %v", animal)
+		}
+	})
+
+	t.Run("UnregisteredInterfaceReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		_, err := Map[Cat, Animal](mapper, Cat{Name: "Tom"})
+		if err != ErrNoMapping {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("MapSliceToInterfaces", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, catToAnimal)
+
+		animals, err := MapSlice[[]Cat, []Animal](mapper, []Cat{{Name: "Tom"}, {Name: "Kitty"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(animals) != 2 || animals[1].Sound() != "Kitty says meow" {
+			t.Errorf("Expected two mapped animals, got %v", animals)
+		}
+	})
+}
+
 // TestMapSlice tests the MapSlice functionality for all 4 pointer/value slice mapping cases
 func TestMapSlice(t *testing.T) {
 	type A struct{ V int }
@@ -448,6 +666,69 @@ func TestMapSlice(t *testing.T) {
 		}
 	})
 
+	t.Run("SlicePointerToValueNilElements", func(t *testing.T) {
+		calls := 0
+		valueFn := func(a A) B { calls++; return B{W: a.V + 1} }
+		ptrResultFn := func(a A) *B { calls++; return &B{W: a.V + 1} }
+
+		cases := []struct {
+			name string
+			src  []*A
+			want []B
+		}{
+			{"LeadingNil", []*A{nil, {1}}, []B{{}, {2}}},
+			{"TrailingNil", []*A{{1}, nil}, []B{{2}, {}}},
+			{"AllNil", []*A{nil, nil, nil}, []B{{}, {}, {}}},
+		}
+		for _, fn := range []any{valueFn, ptrResultFn} {
+			mapper := New()
+			mapper.registry.set(keyOf[A, B](), fn)
+			for _, c := range cases {
+				calls = 0
+				got, err := MapSlice[[]*A, []B](mapper, c.src)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", c.name, err)
+				}
+				if !reflect.DeepEqual(got, c.want) {
+					t.Errorf("%s: expected %v, got %v", c.name, c.want, got)
+				}
+				nonNil := 0
+				for _, a := range c.src {
+					if a != nil {
+						nonNil++
+					}
+				}
+				if calls != nonNil {
+					t.Errorf("%s: expected %d calls, got %d", c.name, nonNil, calls)
+				}
+			}
+		}
+	})
+
+	t.Run("SlicePointerToValueNilElementsOtherHelpers", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, aToB)
+		src := []*A{{1}, nil}
+		want := []B{{2}, {}}
+
+		elems, err := MapElems[*A, B](mapper, src)
+		if err != nil || !reflect.DeepEqual(elems, want) {
+			t.Errorf("MapElems: expected %v, got %v (err: %v)", want, elems, err)
+		}
+		appended, err := MapSliceAppend[[]*A, []B](mapper, src, nil)
+		if err != nil || !reflect.DeepEqual(appended, want) {
+			t.Errorf("MapSliceAppend: expected %v, got %v (err: %v)", want, appended, err)
+		}
+		parallel, err := MapSliceParallelCtx[[]*A, []B](mapper, context.Background(), src, 2)
+		if err != nil || !reflect.DeepEqual(parallel, want) {
+			t.Errorf("MapSliceParallelCtx: expected %v, got %v (err: %v)", want, parallel, err)
+		}
+		first, err := MapFirst[*A, B](mapper, src[1:])
+		if err != nil || first != (B{}) {
+			t.Errorf("MapFirst: expected zero B, got %v (err: %v)", first, err)
+		}
+	})
+
 	t.Run("SliceValueToPointer", func(t *testing.T) {
 		mapper := New()
 		Register(mapper, aToB)
@@ -466,8 +747,381 @@ func TestMapSlice(t *testing.T) {
 			}
 		}
 	})
+	t.Run("PrimitiveElementTypes", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(b byte) int { return int(b) })
+		Register(mapper, func(b byte) rune { return rune(b) })
+		Register(mapper, func(r rune) string { return string(r) })
+		Register(mapper, func(u uint32) uint64 { return uint64(u) << 32 })
+
+		ints, err := MapSlice[[]byte, []int](mapper, []byte("Go"))
+		if err != nil || !reflect.DeepEqual(ints, []int{71, 111}) {
+			t.Errorf("expected [71 111], got %v (err: %v)", ints, err)
+		}
+
+		runes, err := MapSlice[[]byte, []rune](mapper, []byte("ok"))
+		if err != nil || !reflect.DeepEqual(runes, []rune("ok")) {
+			t.Errorf("expected %v, got %v (err: %v)", []rune("ok"), runes, err)
+		}
+
+		strs, err := MapSlice[[]rune, []string](mapper, []rune("héllo"))
+		if err != nil || !reflect.DeepEqual(strs, []string{"h", "é", "l", "l", "o"}) {
+			t.Errorf("expected [h é l l o], got %v (err: %v)", strs, err)
+		}
+
+		wide, err := MapSlice[[]uint32, []uint64](mapper, []uint32{1})
+		if err != nil || !reflect.DeepEqual(wide, []uint64{1 << 32}) {
+			t.Errorf("expected [4294967296], got %v (err: %v)", wide, err)
+		}
+	})
+
+	t.Run("PrimitiveElementTypesAreNotConverted", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(b byte) int { return int(b) })
+
+		_, err := MapSlice[[]rune, []int](mapper, []rune("a"))
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("expected ErrNoMapping for unregistered rune->int, got %v", err)
+		}
+	})
+
+	t.Run("NilSourceReturnsNil", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, aToB)
+
+		got, err := MapSlice[[]A, []B](mapper, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != nil {
+			t.Errorf("expected nil slice, got %#v", got)
+		}
+
+		gotPtrs, err := MapSlice[[]*A, []*B](mapper, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotPtrs != nil {
+			t.Errorf("expected nil slice, got %#v", gotPtrs)
+		}
+	})
+
+	t.Run("EmptySourceReturnsEmpty", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, aToB)
+
+		got, err := MapSlice[[]A, []B](mapper, []A{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Errorf("expected empty non-nil slice, got %#v", got)
+		}
+	})
+
+	t.Run("NilSourceUnregisteredReturnsError", func(t *testing.T) {
+		mapper := New()
+
+		_, err := MapSlice[[]A, []B](mapper, nil)
+		if !errors.Is(err, ErrNoMapping) {
+			t.Errorf("expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("SubslicesWithExtraCapacity", func(t *testing.T) {
+		calls := 0
+		mapper := New()
+		Register(mapper, func(a *A) B { calls++; return B{W: a.V + 1} })
+		RegisterIdentity[int](mapper)
+
+		big := []A{{0}, {1}, {2}, {3}, {4}, {5}, {6}}
+		src := big[2:5]
+		ptrs := []*A{&big[0], &big[1], &big[2], nil, &big[4], &big[5]}[1:3]
+		ints := []int{0, 1, 2, 3, 4, 5}[1:3:5]
+
+		got, err := MapSlice[[]A, []B](mapper, src)
+		if err != nil || !reflect.DeepEqual(got, []B{{3}, {4}, {5}}) || cap(got) != 3 {
+			t.Errorf("Expected [{3} {4} {5}] with cap 3, got %v with cap %d (err: %v)", got, cap(got), err)
+		}
+		if calls != 3 {
+			t.Errorf("Expected 3 calls for a subslice of length 3, got %d", calls)
+		}
+
+		gotPtrs, err := MapSlice[[]*A, []*B](mapper, ptrs)
+		if err != nil || len(gotPtrs) != 2 || gotPtrs[0].W != 2 || gotPtrs[1].W != 3 {
+			t.Errorf("Expected [&{2} &{3}], got %v (err: %v)", gotPtrs, err)
+		}
+
+		gotInts, err := MapSlice[[]int, []int](mapper, ints)
+		if err != nil || !reflect.DeepEqual(gotInts, []int{1, 2}) || cap(gotInts) != 2 {
+			t.Errorf("Expected [1 2] with cap 2, got %v with cap %d (err: %v)", gotInts, cap(gotInts), err)
+		}
+
+		elems, err := MapElems[A, B](mapper, src)
+		if err != nil || !reflect.DeepEqual(elems, []B{{3}, {4}, {5}}) {
+			t.Errorf("MapElems: expected [{3} {4} {5}], got %v (err: %v)", elems, err)
+		}
+		parallel, err := MapSliceParallelCtx[[]A, []B](mapper, context.Background(), src, 2)
+		if err != nil || !reflect.DeepEqual(parallel, []B{{3}, {4}, {5}}) {
+			t.Errorf("MapSliceParallelCtx: expected [{3} {4} {5}], got %v (err: %v)", parallel, err)
+		}
+
+		// Appending into a destination with spare capacity fills only its tail, like append
+		dst := make([]B, 1, 10)
+		appended, err := MapSliceAppend[[]A, []B](mapper, src, dst)
+		if err != nil || !reflect.DeepEqual(appended, []B{{}, {3}, {4}, {5}}) {
+			t.Errorf("MapSliceAppend: expected [{0} {3} {4} {5}], got %v (err: %v)", appended, err)
+		}
+
+		// The source, including the elements past its length, is left untouched
+		if !reflect.DeepEqual(big, []A{{0}, {1}, {2}, {3}, {4}, {5}, {6}}) {
+			t.Errorf("Expected the backing array to be unchanged, got %v", big)
+		}
+	})
+
+	t.Run("NamedSliceTypes", func(t *testing.T) {
+		type SourceList []A
+		type DestList []B
+		type DestPtrList []*B
+
+		mapper := New()
+		Register(mapper, aToB)
+
+		got, err := MapSlice[SourceList, DestList](mapper, SourceList{{1}, {2}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, DestList{{2}, {3}}) {
+			t.Errorf("expected DestList{{2} {3}}, got %#v", got)
+		}
+
+		// The result must carry the requested named type even when boxed
+		var boxed any = got
+		if _, ok := boxed.(DestList); !ok {
+			t.Errorf("expected dynamic type DestList, got %T", boxed)
+		}
+
+		ptrs, err := MapSlice[[]A, DestPtrList](mapper, []A{{5}})
+		if err != nil || len(ptrs) != 1 || ptrs[0].W != 6 {
+			t.Errorf("expected DestPtrList{&{6}}, got %#v (err: %v)", ptrs, err)
+		}
+
+		plain, err := MapSlice[SourceList, []B](mapper, SourceList{{7}})
+		if err != nil || !reflect.DeepEqual(plain, []B{{8}}) {
+			t.Errorf("expected [{8}], got %#v (err: %v)", plain, err)
+		}
+
+		nilList, err := MapSlice[SourceList, DestList](mapper, nil)
+		if err != nil || nilList != nil {
+			t.Errorf("expected a nil DestList, got %#v (err: %v)", nilList, err)
+		}
+	})
+}
+
+
+// TestRegisterKeying tests that every pointer/value signature variant shares one registry key
+func TestRegisterKeying(t *testing.T) {
+	variants := map[string]func(Mapper){
+		"func(Person) PersonDTO": func(m Mapper) { Register(m, personToDTO) },
+		"func(*Person) PersonDTO": func(m Mapper) {
+			Register(m, func(p *Person) PersonDTO { return personToDTO(*p) })
+		},
+		"func(Person) *PersonDTO": func(m Mapper) {
+			Register(m, func(p Person) *PersonDTO { dto := personToDTO(p); return &dto })
+		},
+		"func(*Person) *PersonDTO": func(m Mapper) {
+			Register(m, func(p *Person) *PersonDTO { dto := personToDTO(*p); return &dto })
+		},
+	}
+
+	for name, register := range variants {
+		t.Run(name, func(t *testing.T) {
+			mapper := New()
+			register(mapper)
+
+			if got := List(mapper); !reflect.DeepEqual(got, []string{"mapper.Person-mapper.PersonDTO"}) {
+				t.Errorf("Expected [mapper.Person-mapper.PersonDTO], got %v", got)
+			}
+			if !Has[Person, PersonDTO](mapper) || !Has[*Person, *PersonDTO](mapper) || !Has[*Person, PersonDTO](mapper) {
+				t.Error("Expected Has to find the mapping for every pointer/value form")
+			}
+
+			person := Person{Name: "John", Age: 30}
+			want := PersonDTO{FullName: "John", Years: 30}
+
+			if got, err := Map[Person, PersonDTO](mapper, person); err != nil || got != want {
+				t.Errorf("Person->PersonDTO: expected %+v, got %+v (err: %v)", want, got, err)
+			}
+			if got, err := Map[*Person, PersonDTO](mapper, &person); err != nil || got != want {
+				t.Errorf("*Person->PersonDTO: expected %+v, got %+v (err: %v)", want, got, err)
+			}
+			if got, err := Map[Person, *PersonDTO](mapper, person); err != nil || got == nil || *got != want {
+				t.Errorf("Person->*PersonDTO: expected %+v, got %+v (err: %v)", want, got, err)
+			}
+			if got, err := Map[*Person, *PersonDTO](mapper, &person); err != nil || got == nil || *got != want {
+				t.Errorf("*Person->*PersonDTO: expected %+v, got %+v (err: %v)", want, got, err)
+			}
+
+			Remove[*Person, *PersonDTO](mapper)
+			if Has[Person, PersonDTO](mapper) {
+				t.Error("Expected Remove with pointer types to remove the mapping")
+			}
+		})
+	}
+
+	t.Run("LaterVariantReplacesEarlier", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		Register(mapper, func(p *Person) PersonDTO { return PersonDTO{FullName: "pointer"} })
+
+		if len(List(mapper)) != 1 {
+			t.Errorf("Expected a single mapping, got %v", List(mapper))
+		}
+		if got := MustMap[Person, PersonDTO](mapper, Person{}); got.FullName != "pointer" {
+			t.Errorf("Expected the later registration to win, got %+v", got)
+		}
+	})
 }
 
+// TestMapPointerResult tests that pointers returned by mapping functions are used as-is
+func TestMapPointerResult(t *testing.T) {
+	shared := &PersonDTO{FullName: "Shared"}
+	pointerFunc := func(p Person) *PersonDTO { return shared }
+
+	newMapper := func() Mapper {
+		mapper := New()
+		Register(mapper, pointerFunc)
+		return mapper
+	}
+
+	t.Run("ValueSourceReturnsSamePointer", func(t *testing.T) {
+		result, err := Map[Person, *PersonDTO](newMapper(), Person{Name: "John"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result != shared {
+			t.Errorf("Expected the pointer returned by the function, got a copy %p", result)
+		}
+	})
+
+	t.Run("PointerSourceReturnsSamePointer", func(t *testing.T) {
+		result, err := Map[*Person, *PersonDTO](newMapper(), &Person{Name: "John"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result != shared {
+			t.Errorf("Expected the pointer returned by the function, got a copy %p", result)
+		}
+	})
+
+	t.Run("FewerAllocationsThanValueFunc", func(t *testing.T) {
+		pointerMapper := newMapper()
+		valueMapper := New()
+		Register(valueMapper, personToDTO)
+		person := Person{Name: "John"}
+
+		pointerAllocs := testing.AllocsPerRun(100, func() {
+			_, _ = Map[Person, *PersonDTO](pointerMapper, person)
+		})
+		valueAllocs := testing.AllocsPerRun(100, func() {
+			_, _ = Map[Person, *PersonDTO](valueMapper, person)
+		})
+		if pointerAllocs >= valueAllocs {
+			t.Errorf("Expected fewer allocations for pointer results, got %v vs %v", pointerAllocs, valueAllocs)
+		}
+	})
+}
+
+// TestGenericStructMapping tests mapping between instantiations of generic types
+func TestGenericStructMapping(t *testing.T) {
+	t.Run("MapGenericInstantiation", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(g GenericStruct[int]) GenericStructDTO[int] {
+			return GenericStructDTO[int]{Data: g.Data * 2}
+		})
+
+		result, err := Map[GenericStruct[int], GenericStructDTO[int]](mapper, GenericStruct[int]{Data: 21})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Data != 42 {
+			t.Errorf("Expected 42, got %d", result.Data)
+		}
+	})
+
+	t.Run("InstantiationsAreDistinctKeys", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(g GenericStruct[int]) GenericStructDTO[int] {
+			return GenericStructDTO[int]{Data: g.Data}
+		})
+
+		if !Has[GenericStruct[int], GenericStructDTO[int]](mapper) {
+			t.Error("Expected GenericStruct[int] mapping to be registered")
+		}
+		if Has[GenericStruct[string], GenericStructDTO[string]](mapper) {
+			t.Error("Expected GenericStruct[string] mapping to not exist")
+		}
+
+		_, err := Map[GenericStruct[string], GenericStructDTO[string]](mapper, GenericStruct[string]{Data: "x"})
+		if err != ErrNoMapping {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+
+	t.Run("InstantiationsMapIndependently", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, func(g GenericStruct[int]) GenericStructDTO[int] {
+			return GenericStructDTO[int]{Data: g.Data + 1}
+		})
+		Register(mapper, func(g GenericStruct[string]) GenericStructDTO[string] {
+			return GenericStructDTO[string]{Data: g.Data + "!"}
+		})
+
+		intResult, err := Map[GenericStruct[int], GenericStructDTO[int]](mapper, GenericStruct[int]{Data: 1})
+		if err != nil || intResult.Data != 2 {
+			t.Errorf("Expected {2}, got %+v (err: %v)", intResult, err)
+		}
+		strResult, err := Map[GenericStruct[string], GenericStructDTO[string]](mapper, GenericStruct[string]{Data: "hi"})
+		if err != nil || strResult.Data != "hi!" {
+			t.Errorf("Expected {hi!}, got %+v (err: %v)", strResult, err)
+		}
+
+		mappings := List(mapper)
+		sort.Strings(mappings)
+		expected := []string{
+			"mapper.GenericStruct[int]-mapper.GenericStructDTO[int]",
+			"mapper.GenericStruct[string]-mapper.GenericStructDTO[string]",
+		}
+		if !reflect.DeepEqual(mappings, expected) {
+			t.Errorf("Expected %v, got %v", expected, mappings)
+		}
+	})
+
+	t.Run("TypeParameterNeedsMapping", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+		Register(mapper, func(g GenericStruct[Person]) GenericStruct[PersonDTO] {
+			return GenericStruct[PersonDTO]{Data: MustMap[Person, PersonDTO](mapper, g.Data)}
+		})
+
+		src := GenericStruct[Person]{Data: Person{Name: "John", Age: 30}}
+		result, err := Map[GenericStruct[Person], GenericStruct[PersonDTO]](mapper, src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Data.FullName != "John" || result.Data.Years != 30 {
+			t.Errorf("Expected {John 30}, got %+v", result.Data)
+		}
+
+		ptrResult, err := Map[*GenericStruct[Person], *GenericStruct[PersonDTO]](mapper, &src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if ptrResult == nil || ptrResult.Data.FullName != "John" {
+			t.Errorf("Expected pointer to {John 30}, got %+v", ptrResult)
+		}
+	})
+}
 
 // TestHasMapping tests the mapping existence check
 func TestHasMapping(t *testing.T) {
@@ -480,6 +1134,24 @@ func TestHasMapping(t *testing.T) {
 		}
 	})
 
+	t.Run("HasMappingDistinguishesIdenticallyNamedTypes", func(t *testing.T) {
+		type Person struct {
+			Name string
+			Age  int
+		}
+
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		if Has[Person, PersonDTO](mapper) {
+			t.Error("Expected local Person type to be distinct from the package-level Person")
+		}
+		Register(mapper, func(a Animal) string { return a.Sound() })
+		if !Has[Animal, string](mapper) {
+			t.Error("Expected interface source mapping to be found")
+		}
+	})
+
 	t.Run("HasMappingReturnsFalseForUnregistered", func(t *testing.T) {
 		mapper := New()
 
@@ -614,6 +1286,31 @@ func TestList(t *testing.T) {
 		}
 	})
 
+	t.Run("ListIsSorted", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+		Register(mapper, personToDTO)
+		Register(mapper, intToString)
+		Register(mapper, emptyToEmpty)
+
+		for i := 0; i < 5; i++ {
+			mappings := List(mapper)
+			if !sort.StringsAreSorted(mappings) {
+				t.Fatalf("Expected sorted mappings, got %v", mappings)
+			}
+		}
+
+		expected := []string{
+			"int-string",
+			"mapper.EmptyStruct-mapper.EmptyStruct",
+			"mapper.Person-mapper.PersonDTO",
+			"string-int",
+		}
+		if got := List(mapper); !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
 	t.Run("ListSingleMapping", func(t *testing.T) {
 		mapper := New()
 		Register(mapper, stringToInt)
@@ -693,3 +1390,385 @@ func TestList(t *testing.T) {
 		}
 	})
 }
+
+// TestListFunc tests listing mappings with a custom formatter
+func TestListFunc(t *testing.T) {
+	t.Run("CustomFormatter", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+		Register(mapper, personToDTO)
+
+		mappings := ListFunc(mapper, func(src, dst reflect.Type) string {
+			return src.Name() + " → " + dst.Name()
+		})
+		sort.Strings(mappings)
+
+		expected := []string{"Person → PersonDTO", "string → int"}
+		if !reflect.DeepEqual(mappings, expected) {
+			t.Errorf("Expected %v, got %v", expected, mappings)
+		}
+	})
+
+	t.Run("FormatterReceivesRegisteredTypes", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		ListFunc(mapper, func(src, dst reflect.Type) string {
+			if src != reflect.TypeOf(Person{}) || dst != reflect.TypeOf(PersonDTO{}) {
+				t.Errorf("Expected Person and PersonDTO types, got %v and %v", src, dst)
+			}
+			return ""
+		})
+	})
+
+	t.Run("EmptyRegistry", func(t *testing.T) {
+		mapper := New()
+
+		mappings := ListFunc(mapper, func(src, dst reflect.Type) string {
+			t.Error("Formatter should not be called for an empty registry")
+			return ""
+		})
+		if len(mappings) != 0 {
+			t.Errorf("Expected 0 mappings, got %d", len(mappings))
+		}
+	})
+}
+
+// TestSliceTypeError tests the error reported when MapSlice is given non-slice types
+func TestSliceTypeError(t *testing.T) {
+	mapper := New()
+	Register(mapper, personToDTO)
+
+	tests := []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{
+			name: "SourceWrong",
+			call: func() error { _, err := MapSlice[Person, []PersonDTO](mapper, Person{}); return err },
+			want: "MapSlice: source is struct but destination is slice",
+		},
+		{
+			name: "DestinationWrong",
+			call: func() error { _, err := MapSlice[[]Person, PersonDTO](mapper, nil); return err },
+			want: "MapSlice: source is slice but destination is struct",
+		},
+		{
+			name: "BothWrong",
+			call: func() error { _, err := MapSlice[Person, PersonDTO](mapper, Person{}); return err },
+			want: "MapSlice: source is struct and destination is struct",
+		},
+		{
+			name: "NilInterfaceSource",
+			call: func() error { _, err := MapSlice[any, []PersonDTO](mapper, nil); return err },
+			want: "MapSlice: source is nil but destination is slice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, ErrSrcAndDestMustBeSlices) {
+				t.Errorf("Expected ErrSrcAndDestMustBeSlices, got %v", err)
+			}
+			var typeErr *SliceTypeError
+			if !errors.As(err, &typeErr) {
+				t.Fatalf("Expected *SliceTypeError, got %T", err)
+			}
+			if err.Error() != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, err.Error())
+			}
+		})
+	}
+}
+
+// TestMapUntypedNil tests mapping a nil interface value, which carries no dynamic type
+func TestMapUntypedNil(t *testing.T) {
+	mapper := New()
+	Register(mapper, personToDTO)
+
+	t.Run("Map", func(t *testing.T) {
+		_, err := Map[any, PersonDTO](mapper, nil)
+		if !errors.Is(err, ErrUntypedNil) {
+			t.Errorf("Expected ErrUntypedNil, got %v", err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "cannot map from untyped nil source") {
+			t.Errorf("Expected descriptive message, got %q", err.Error())
+		}
+	})
+
+	t.Run("MapWith", func(t *testing.T) {
+		_, err := MapWith[any, PersonDTO](mapper, nil, WithCaseInsensitive())
+		if !errors.Is(err, ErrUntypedNil) {
+			t.Errorf("Expected ErrUntypedNil, got %v", err)
+		}
+	})
+
+	t.Run("TypedValueThroughAny", func(t *testing.T) {
+		dto, err := Map[any, PersonDTO](mapper, Person{Name: "Alice"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto.FullName != "Alice" {
+			t.Errorf("Expected Alice, got %q", dto.FullName)
+		}
+	})
+}
+
+// TestListByKind tests listing only auto-mapped or only manual mappings
+func TestListByKind(t *testing.T) {
+	t.Run("MixedRegistrations", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, stringToInt)
+		RegisterAutoMap[Person, PersonDTO](mapper)
+		RegisterAutoMapWithOptions[EmptyStruct, Cat](mapper, AutoMapOptions{CaseInsensitive: true})
+		if err := RegisterMany(mapper, intToString); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		wantAuto := []string{
+			"mapper.Cat-mapper.EmptyStruct",
+			"mapper.EmptyStruct-mapper.Cat",
+			"mapper.Person-mapper.PersonDTO",
+			"mapper.PersonDTO-mapper.Person",
+		}
+		if got := ListByKind(mapper, true); !reflect.DeepEqual(got, wantAuto) {
+			t.Errorf("Expected %v, got %v", wantAuto, got)
+		}
+
+		wantManual := []string{"int-string", "string-int"}
+		if got := ListByKind(mapper, false); !reflect.DeepEqual(got, wantManual) {
+			t.Errorf("Expected %v, got %v", wantManual, got)
+		}
+	})
+
+	t.Run("ManualRegistrationReplacesAutoMapping", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[Person, PersonDTO](mapper)
+		Register(mapper, personToDTO)
+
+		if got := ListByKind(mapper, true); !reflect.DeepEqual(got, []string{"mapper.PersonDTO-mapper.Person"}) {
+			t.Errorf("Expected only the reverse pair to stay auto-mapped, got %v", got)
+		}
+		if got := ListByKind(mapper, false); !reflect.DeepEqual(got, []string{"mapper.Person-mapper.PersonDTO"}) {
+			t.Errorf("Expected Person->PersonDTO to be manual, got %v", got)
+		}
+	})
+
+	t.Run("RemoveAndOverride", func(t *testing.T) {
+		mapper := New()
+		RegisterAutoMap[Person, PersonDTO](mapper)
+		Remove[PersonDTO, Person](mapper)
+
+		WithOverride(mapper, personToDTO, func() {
+			if got := ListByKind(mapper, true); len(got) != 0 {
+				t.Errorf("Expected no auto-mapped pairs during override, got %v", got)
+			}
+		})
+		if got := ListByKind(mapper, true); !reflect.DeepEqual(got, []string{"mapper.Person-mapper.PersonDTO"}) {
+			t.Errorf("Expected override to restore the auto-mapped pair, got %v", got)
+		}
+	})
+
+	t.Run("EmptyMapper", func(t *testing.T) {
+		mapper := New()
+		if got := ListByKind(mapper, true); len(got) != 0 {
+			t.Errorf("Expected 0 mappings, got %v", got)
+		}
+		if got := ListByKind(mapper, false); len(got) != 0 {
+			t.Errorf("Expected 0 mappings, got %v", got)
+		}
+	})
+}
+
+// TestSignature tests inspecting the type of a registered mapping function
+func TestSignature(t *testing.T) {
+	tests := []struct {
+		name     string
+		register func(m Mapper)
+		want     reflect.Type
+	}{
+		{
+			name:     "ValueToValue",
+			register: func(m Mapper) { Register(m, personToDTO) },
+			want:     reflect.TypeOf(personToDTO),
+		},
+		{
+			name: "PointerSource",
+			register: func(m Mapper) {
+				Register(m, func(p *Person) PersonDTO { return personToDTO(*p) })
+			},
+			want: reflect.TypeFor[func(*Person) PersonDTO](),
+		},
+		{
+			name: "PointerResult",
+			register: func(m Mapper) {
+				Register(m, func(p Person) *PersonDTO { dto := personToDTO(p); return &dto })
+			},
+			want: reflect.TypeFor[func(Person) *PersonDTO](),
+		},
+		{
+			name: "BoxedSource",
+			register: func(m Mapper) {
+				RegisterMulti(m, func(src any) PersonDTO { return PersonDTO{} }, reflect.TypeFor[Person]())
+			},
+			want: reflect.TypeFor[func(any) PersonDTO](),
+		},
+		{
+			name:     "AutoMapped",
+			register: func(m Mapper) { RegisterAutoMap[Person, PersonDTO](m) },
+			want:     reflect.TypeFor[func(Person) PersonDTO](),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := New()
+			tt.register(mapper)
+
+			for _, signature := range []func(Mapper) (reflect.Type, bool){
+				Signature[Person, PersonDTO],
+				Signature[*Person, *PersonDTO],
+			} {
+				fnType, ok := signature(mapper)
+				if !ok || fnType != tt.want {
+					t.Errorf("Expected %v, true, got %v, %v", tt.want, fnType, ok)
+				}
+			}
+		})
+	}
+
+	t.Run("Unregistered", func(t *testing.T) {
+		mapper := New()
+		Register(mapper, personToDTO)
+
+		if fnType, ok := Signature[PersonDTO, Person](mapper); ok || fnType != nil {
+			t.Errorf("Expected nil, false, got %v, %v", fnType, ok)
+		}
+	})
+}
+
+// TestDiff tests comparing the registered pairs of two mappers
+func TestDiff(t *testing.T) {
+	t.Run("OverlappingSets", func(t *testing.T) {
+		a, b := New(), New()
+		Register(a, stringToInt)
+		Register(a, personToDTO)
+		Register(b, stringToInt)
+		Register(b, intToString)
+		RegisterAutoMap[Person, PersonDTO](b)
+
+		onlyA, onlyB := Diff(a, b)
+		if onlyA != nil {
+			t.Errorf("Expected nothing only in a, got %v", onlyA)
+		}
+		wantB := []string{"int-string", "mapper.PersonDTO-mapper.Person"}
+		if !reflect.DeepEqual(onlyB, wantB) {
+			t.Errorf("Expected %v, got %v", wantB, onlyB)
+		}
+	})
+
+	t.Run("DisjointSets", func(t *testing.T) {
+		a, b := New(), New()
+		Register(a, stringToInt)
+		Register(b, intToString)
+
+		onlyA, onlyB := Diff(a, b)
+		if !reflect.DeepEqual(onlyA, []string{"string-int"}) || !reflect.DeepEqual(onlyB, []string{"int-string"}) {
+			t.Errorf("Expected [string-int] and [int-string], got %v and %v", onlyA, onlyB)
+		}
+	})
+
+	t.Run("IdenticalSets", func(t *testing.T) {
+		a, b := New(), New()
+		Register(a, stringToInt)
+		Register(b, func(s string) int { return 0 })
+
+		if onlyA, onlyB := Diff(a, b); onlyA != nil || onlyB != nil {
+			t.Errorf("Expected no differences, got %v and %v", onlyA, onlyB)
+		}
+	})
+}
+
+// TestResetGlobal tests clearing the Global mapper in place
+func TestResetGlobal(t *testing.T) {
+	defer ResetGlobal()
+
+	alias := Global
+	Register(Global, stringToInt)
+	RegisterAutoMap[Person, PersonDTO](Global)
+	RegisterWhen(Global, func(s string) bool { return s == "" }, func(string) int { return -1 })
+	SetFallback(Global, jsonFallback)
+
+	ResetGlobal()
+
+	if got := List(Global); len(got) != 0 {
+		t.Errorf("Expected no mappings, got %v", got)
+	}
+	if got := List(alias); len(got) != 0 {
+		t.Errorf("Expected earlier copies to be reset, got %v", got)
+	}
+	if _, err := Map[string, int](alias, ""); !errors.Is(err, ErrNoMapping) {
+		t.Errorf("Expected ErrNoMapping, got %v", err)
+	}
+}
+
+// TestMapLike tests mapping with the destination type inferred from a sample value
+func TestMapLike(t *testing.T) {
+	mapper := New()
+	Register(mapper, personToDTO)
+
+	t.Run("InfersValueDestination", func(t *testing.T) {
+		dto, err := MapLike(mapper, Person{Name: "Ann", Age: 30}, PersonDTO{FullName: "ignored"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if dto != (PersonDTO{FullName: "Ann", Years: 30}) {
+			t.Errorf("Expected {Ann 30}, got %v", dto)
+		}
+	})
+
+	t.Run("InfersPointerDestination", func(t *testing.T) {
+		dto, err := MapLike(mapper, &Person{Name: "Ann"}, (*PersonDTO)(nil))
+		if err != nil || dto == nil || dto.FullName != "Ann" {
+			t.Errorf("Expected &{Ann 0}, got %v, %v", dto, err)
+		}
+	})
+
+	t.Run("Unregistered", func(t *testing.T) {
+		if _, err := MapLike(mapper, "text", 0); !errors.Is(err, ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+	})
+}
+
+// TestListInto tests appending the registered pairs to a caller-supplied buffer
+func TestListInto(t *testing.T) {
+	mapper := New()
+	Register(mapper, stringToInt)
+	Register(mapper, personToDTO)
+	Register(mapper, intToString)
+
+	t.Run("MatchesList", func(t *testing.T) {
+		if got, want := ListInto(mapper, nil), List(mapper); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("KeepsExistingElements", func(t *testing.T) {
+		got := ListInto(mapper, []string{"z-first"})
+		want := append([]string{"z-first"}, List(mapper)...)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("ReusesBuffer", func(t *testing.T) {
+		buf := make([]string, 0, 8)
+		got := ListInto(mapper, buf)
+		if &got[0] != &buf[:1][0] {
+			t.Error("Expected the buffer to be reused")
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"fmt"
+	"time"
+)
+
+// RegisterTimeConversions registers ready-made mappings between time.Time and its common
+// wire representations, replacing the converters most projects write by hand:
+//
+//   - string -> time.Time parses with layout in loc; time.Time -> string formats in loc
+//   - int64 -> time.Time reads Unix seconds into loc; time.Time -> int64 returns Unix seconds
+//   - time.Time -> time.Time converts to loc, keeping the instant
+//
+// Mapping a string that does not match layout makes Map return an error wrapping the
+// *time.ParseError, so errors.As can extract the details. Existing mappings for these pairs
+// are replaced.
+//
+// Parameters:
+//   - m: The mapper instance to register the conversions with
+//   - loc: The location times are parsed in and converted to; nil means time.UTC
+//   - layout: The layout used to parse and format strings; "" means time.RFC3339
+//
+// Example:
+//
+//	mapper := New()
+//	RegisterTimeConversions(mapper, time.UTC, time.RFC3339)
+//
+//	created, err := Map[string, time.Time](mapper, "2024-05-01T10:00:00Z")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	unix, _ := Map[time.Time, int64](mapper, created)
+//	fmt.Println(unix) // Output: 1714557600
+func RegisterTimeConversions(m Mapper, loc *time.Location, layout string) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	registerFallible(m, func(s string) time.Time {
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err != nil {
+			failMapping(fmt.Errorf("parse time %q with layout %q: %w", s, layout, err))
+		}
+		return t
+	})
+	Register(m, func(t time.Time) string { return t.In(loc).Format(layout) })
+	Register(m, func(sec int64) time.Time { return time.Unix(sec, 0).In(loc) })
+	Register(m, func(t time.Time) int64 { return t.Unix() })
+	Register(m, func(t time.Time) time.Time { return t.In(loc) })
+}
@@ -0,0 +1,113 @@
+package mapper
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestRegisterTimeConversions tests the built-in time.Time conversions
+func TestRegisterTimeConversions(t *testing.T) {
+	hanoi := time.FixedZone("ICT", 7*60*60)
+	instant := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	newMapper := func() Mapper {
+		mapper := New()
+		RegisterTimeConversions(mapper, hanoi, "")
+		return mapper
+	}
+
+	t.Run("StringToTime", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[string, time.Time](mapper, "2024-05-01T17:00:00+07:00")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !got.Equal(instant) {
+			t.Errorf("Expected %v, got %v", instant, got)
+		}
+	})
+
+	t.Run("TimeToString", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[time.Time, string](mapper, instant)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != "2024-05-01T17:00:00+07:00" {
+			t.Errorf("Expected 2024-05-01T17:00:00+07:00, got %s", got)
+		}
+	})
+
+	t.Run("UnixToTime", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[int64, time.Time](mapper, instant.Unix())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !got.Equal(instant) || got.Location() != hanoi {
+			t.Errorf("Expected %v in ICT, got %v", instant, got)
+		}
+	})
+
+	t.Run("TimeToUnix", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[time.Time, int64](mapper, instant)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != 1714557600 {
+			t.Errorf("Expected 1714557600, got %d", got)
+		}
+	})
+
+	t.Run("TimeToLocation", func(t *testing.T) {
+		mapper := newMapper()
+
+		got, err := Map[time.Time, time.Time](mapper, instant)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !got.Equal(instant) || got.Hour() != 17 {
+			t.Errorf("Expected 17:00 ICT, got %v", got)
+		}
+	})
+
+	t.Run("CustomLayout", func(t *testing.T) {
+		mapper := New()
+		RegisterTimeConversions(mapper, nil, time.DateOnly)
+
+		got, err := Map[string, time.Time](mapper, "2024-05-01")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !got.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Expected 2024-05-01 UTC, got %v", got)
+		}
+	})
+
+	t.Run("MalformedInput", func(t *testing.T) {
+		mapper := newMapper()
+
+		_, err := Map[string, time.Time](mapper, "yesterday")
+		var parseErr *time.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("Expected *time.ParseError, got %v", err)
+		}
+
+		text := "yesterday"
+		_, err = Map[*string, time.Time](mapper, &text)
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Expected *time.ParseError through the reflection path, got %v", err)
+		}
+
+		_, err = MapSlice[[]string, []time.Time](mapper, []string{"2024-05-01T17:00:00+07:00", "bad"})
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Expected *time.ParseError from MapSlice, got %v", err)
+		}
+	})
+}
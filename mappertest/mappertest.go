@@ -0,0 +1,139 @@
+// Package mappertest provides helpers for testing mappings registered with package mapper.
+package mappertest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	mapper "github.com/hotrungnhan/go-automapper"
+)
+
+// ErrRoundTripMismatch is returned by AssertRoundTrip when mapping a value to the
+// destination type and back does not reproduce the original value.
+var ErrRoundTripMismatch = errors.New("round trip mismatch")
+
+// AssertRoundTrip maps src to D and back to S, and checks that the result is deeply equal
+// to src. It is intended for symmetric mappings such as those created by RegisterAutoMap,
+// where a lossy field would otherwise go unnoticed.
+//
+// Type Parameters:
+//   - S: Source type
+//   - D: Intermediate destination type
+//
+// Parameters:
+//   - m: The mapper instance containing both S -> D and D -> S mappings
+//   - src: The value to round-trip
+//
+// Returns:
+//   - error: nil if the round trip reproduces src; an error from either Map call; or an
+//     error wrapping ErrRoundTripMismatch that describes the first diverging field
+//
+// Example:
+//
+//	func TestUserRoundTrip(t *testing.T) {
+//	    m := mapper.New()
+//	    mapper.RegisterAutoMap[User, UserDTO](m)
+//
+//	    if err := mappertest.AssertRoundTrip[User, UserDTO](m, User{Name: "John"}); err != nil {
+//	        t.Error(err)
+//	    }
+//	}
+func AssertRoundTrip[S any, D any](m mapper.Mapper, src S) error {
+	dst, err := mapper.Map[S, D](m, src)
+	if err != nil {
+		return fmt.Errorf("map %s -> %s: %w", typeName[S](), typeName[D](), err)
+	}
+
+	back, err := mapper.Map[D, S](m, dst)
+	if err != nil {
+		return fmt.Errorf("map %s -> %s: %w", typeName[D](), typeName[S](), err)
+	}
+
+	if reflect.DeepEqual(src, back) {
+		return nil
+	}
+
+	path, got, want := firstDiff(typeName[S](), reflect.ValueOf(&back).Elem(), reflect.ValueOf(&src).Elem())
+	return fmt.Errorf("%w at %s: got %s, want %s", ErrRoundTripMismatch, path, got, want)
+}
+
+// typeName returns the name of T for use in error messages.
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// firstDiff walks got and want in parallel and returns the path of the first location
+// where they differ, together with a printable form of both values at that location.
+func firstDiff(path string, got, want reflect.Value) (string, string, string) {
+	if !got.IsValid() || !want.IsValid() || got.Type() != want.Type() {
+		return path, describe(got), describe(want)
+	}
+
+	switch got.Kind() {
+	case reflect.Struct:
+		for i := 0; i < got.NumField(); i++ {
+			field := got.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if !reflect.DeepEqual(got.Field(i).Interface(), want.Field(i).Interface()) {
+				return firstDiff(path+"."+field.Name, got.Field(i), want.Field(i))
+			}
+		}
+	case reflect.Ptr, reflect.Interface:
+		if got.IsNil() != want.IsNil() {
+			return path, describe(got), describe(want)
+		}
+		if !got.IsNil() {
+			return firstDiff(path, got.Elem(), want.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		if got.Kind() == reflect.Slice && got.IsNil() != want.IsNil() {
+			return path, describe(got), describe(want)
+		}
+		if got.Len() != want.Len() {
+			return path + ".len", fmt.Sprint(got.Len()), fmt.Sprint(want.Len())
+		}
+		for i := 0; i < got.Len(); i++ {
+			if !reflect.DeepEqual(got.Index(i).Interface(), want.Index(i).Interface()) {
+				return firstDiff(fmt.Sprintf("%s[%d]", path, i), got.Index(i), want.Index(i))
+			}
+		}
+	case reflect.Map:
+		if got.IsNil() != want.IsNil() {
+			return path, describe(got), describe(want)
+		}
+		keys := want.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, k := range keys {
+			elemPath := fmt.Sprintf("%s[%v]", path, k.Interface())
+			gotElem := got.MapIndex(k)
+			if !gotElem.IsValid() {
+				return elemPath, "<missing>", describe(want.MapIndex(k))
+			}
+			if !reflect.DeepEqual(gotElem.Interface(), want.MapIndex(k).Interface()) {
+				return firstDiff(elemPath, gotElem, want.MapIndex(k))
+			}
+		}
+		if got.Len() != want.Len() {
+			return path + ".len", fmt.Sprint(got.Len()), fmt.Sprint(want.Len())
+		}
+	}
+
+	return path, describe(got), describe(want)
+}
+
+// describe formats a reflected value for an error message.
+func describe(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<invalid>"
+	}
+	if !v.CanInterface() {
+		return fmt.Sprintf("<%s>", v.Type())
+	}
+	return fmt.Sprintf("%#v", v.Interface())
+}
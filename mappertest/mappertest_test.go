@@ -0,0 +1,129 @@
+package mappertest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	mapper "github.com/hotrungnhan/go-automapper"
+)
+
+type Address struct {
+	Street string
+	City   string
+}
+
+type User struct {
+	Name    string
+	Email   string
+	Address Address
+	Tags    []string
+	Attrs   map[string]int
+}
+
+type UserDTO struct {
+	Name    string
+	Email   string
+	Address Address
+	Tags    []string
+	Attrs   map[string]int
+}
+
+// TestAssertRoundTrip tests the round-trip assertion helper
+func TestAssertRoundTrip(t *testing.T) {
+	user := User{
+		Name:    "John",
+		Email:   "john@example.com",
+		Address: Address{Street: "123 Main St", City: "NYC"},
+		Tags:    []string{"dev", "go"},
+		Attrs:   map[string]int{"age": 30},
+	}
+
+	t.Run("SymmetricAutoMapPasses", func(t *testing.T) {
+		m := mapper.New()
+		mapper.RegisterAutoMap[User, UserDTO](m)
+
+		if err := AssertRoundTrip[User, UserDTO](m, user); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("LossyFieldIsReported", func(t *testing.T) {
+		m := mapper.New()
+		mapper.Register(m, func(u User) UserDTO {
+			dto := UserDTO(u)
+			dto.Email = ""
+			return dto
+		})
+		mapper.Register(m, func(d UserDTO) User { return User(d) })
+
+		err := AssertRoundTrip[User, UserDTO](m, user)
+		if !errors.Is(err, ErrRoundTripMismatch) {
+			t.Fatalf("Expected ErrRoundTripMismatch, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "mappertest.User.Email") {
+			t.Errorf("Expected error to name the Email field, got: %v", err)
+		}
+		if !strings.Contains(err.Error(), `"john@example.com"`) {
+			t.Errorf("Expected error to show the original value, got: %v", err)
+		}
+	})
+
+	t.Run("NestedFieldIsReported", func(t *testing.T) {
+		m := mapper.New()
+		mapper.Register(m, func(u User) UserDTO { return UserDTO(u) })
+		mapper.Register(m, func(d UserDTO) User {
+			u := User(d)
+			u.Address.City = "LA"
+			return u
+		})
+
+		err := AssertRoundTrip[User, UserDTO](m, user)
+		if err == nil || !strings.Contains(err.Error(), "mappertest.User.Address.City") {
+			t.Errorf("Expected error to name Address.City, got: %v", err)
+		}
+	})
+
+	t.Run("SliceElementIsReported", func(t *testing.T) {
+		m := mapper.New()
+		mapper.Register(m, func(u User) UserDTO { return UserDTO(u) })
+		mapper.Register(m, func(d UserDTO) User {
+			u := User(d)
+			u.Tags = []string{d.Tags[0], "rust"}
+			return u
+		})
+
+		err := AssertRoundTrip[User, UserDTO](m, user)
+		if err == nil || !strings.Contains(err.Error(), "mappertest.User.Tags[1]") {
+			t.Errorf("Expected error to name Tags[1], got: %v", err)
+		}
+	})
+
+	t.Run("MapEntryIsReported", func(t *testing.T) {
+		m := mapper.New()
+		mapper.Register(m, func(u User) UserDTO { return UserDTO(u) })
+		mapper.Register(m, func(d UserDTO) User {
+			u := User(d)
+			u.Attrs = map[string]int{"age": 31}
+			return u
+		})
+
+		err := AssertRoundTrip[User, UserDTO](m, user)
+		if err == nil || !strings.Contains(err.Error(), "mappertest.User.Attrs[age]") {
+			t.Errorf("Expected error to name Attrs[age], got: %v", err)
+		}
+	})
+
+	t.Run("MissingReverseMappingReturnsError", func(t *testing.T) {
+		m := mapper.New()
+		mapper.Register(m, func(u User) UserDTO { return UserDTO(u) })
+
+		err := AssertRoundTrip[User, UserDTO](m, user)
+		if !errors.Is(err, mapper.ErrNoMapping) {
+			t.Errorf("Expected ErrNoMapping, got %v", err)
+		}
+		if errors.Is(err, ErrRoundTripMismatch) {
+			t.Errorf("Expected missing mapping to not be reported as a mismatch, got %v", err)
+		}
+	})
+}